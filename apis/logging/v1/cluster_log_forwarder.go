@@ -196,6 +196,15 @@ func (output *OutputSpec) GetMaxRecordsPerSecond() int64 {
 	return output.Limit.MaxRecordsPerSecond
 }
 
+// GetServiceAccountToken returns the projected service account token the output authenticates with.
+// LokiStack outputs without a secret authenticate with a token of the collector service account
+func (output *OutputSpec) GetServiceAccountToken() *ServiceAccountToken {
+	if output.ServiceAccountToken == nil && output.Type == OutputTypeLokiStack && output.Secret == nil {
+		return &ServiceAccountToken{}
+	}
+	return output.ServiceAccountToken
+}
+
 func IsAuditHttpReceiver(input *InputSpec) bool {
 	return input.Receiver != nil &&
 		input.Receiver.HTTP != nil &&
@@ -212,3 +221,16 @@ func IsSyslogReceiver(input *InputSpec) bool {
 	return input.Receiver != nil &&
 		input.Receiver.Type == ReceiverTypeSyslog
 }
+
+// ReceiverLogType returns the log type of the records received by a receiver input, empty for other inputs
+func ReceiverLogType(input *InputSpec) string {
+	switch {
+	case input.Receiver == nil || input.Receiver.ReceiverTypeSpec == nil:
+		return ""
+	case IsHttpReceiver(input) && input.Receiver.HTTP != nil:
+		return input.Receiver.HTTP.GetLogType()
+	case IsSyslogReceiver(input) && input.Receiver.Syslog != nil:
+		return input.Receiver.Syslog.GetLogType()
+	}
+	return ""
+}
@@ -56,6 +56,19 @@ type ClusterLogForwarderSpec struct {
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Forwarder Pipelines",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:forwarderPipelines"}
 	Pipelines []PipelineSpec `json:"pipelines,omitempty"`
 
+	// InvalidPipelinePolicy defines how the forwarder handles pipelines that fail validation.
+	//
+	// `Fail` (default) marks the ClusterLogForwarder invalid when any pipeline is invalid and
+	// stops updating the collector.
+	//
+	// `Drop` reports invalid pipelines in `status.pipelines` and excludes them from the collector
+	// configuration, valid pipelines continue to forward logs. The forwarder is marked `Degraded`.
+	// The forwarder is still invalid if no pipeline is valid.
+	//
+	// +kubebuilder:validation:Enum:=Fail;Drop
+	// +optional
+	InvalidPipelinePolicy InvalidPipelinePolicy `json:"invalidPipelinePolicy,omitempty"`
+
 	// ServiceAccountName is the serviceaccount associated with the clusterlogforwarder
 	//
 	// +optional
@@ -76,6 +89,17 @@ type ClusterLogForwarderSpec struct {
 	OutputDefaults *OutputDefaults `json:"outputDefaults,omitempty"`
 }
 
+// InvalidPipelinePolicy defines how the forwarder handles pipelines that fail validation.
+type InvalidPipelinePolicy string
+
+const (
+	// InvalidPipelinePolicyFail marks the forwarder invalid if any pipeline is invalid
+	InvalidPipelinePolicyFail InvalidPipelinePolicy = "Fail"
+
+	// InvalidPipelinePolicyDrop excludes invalid pipelines and forwards the valid ones
+	InvalidPipelinePolicyDrop InvalidPipelinePolicy = "Drop"
+)
+
 // ClusterLogForwarderStatus defines the observed state of ClusterLogForwarder
 type ClusterLogForwarderStatus struct {
 	// Conditions of the log forwarder.
@@ -142,7 +166,7 @@ type OutputSpec struct {
 
 	// Type of output plugin.
 	//
-	// +kubebuilder:validation:Enum:=syslog;fluentdForward;elasticsearch;kafka;cloudwatch;loki;googleCloudLogging;splunk;http
+	// +kubebuilder:validation:Enum:=syslog;fluentdForward;elasticsearch;kafka;cloudwatch;loki;googleCloudLogging;splunk;http;otlp;s3;azureMonitor;lokiStack
 	// +required
 	Type string `json:"type"`
 
@@ -199,16 +223,22 @@ type OutputSpec struct {
 	//
 	// Simple Authentication Security Layer (SASL)
 	//
-	//   * `sasl.enable`: (boolean) Explicitly enable or disable SASL.
-	//     If missing, SASL is automatically enabled if any `sasl.*` keys are set.
-	//   * `sasl.mechanisms`: (array of string) List of allowed SASL mechanism names.
-	//     If missing or empty, the system defaults are used.
+	//   * `sasl.enable`: Deprecated, use `kafka.sasl`. Enables SASL for Kafka outputs.
+	//   * `sasl.mechanisms`: Deprecated, use `kafka.sasl.mechanism`. The SASL mechanism of Kafka outputs.
 	//   * `sasl.allow-insecure`: (boolean) Allow mechanisms that send clear-text passwords.
 	//     Default false.
 	//
 	// +optional
 	Secret *OutputSecretSpec `json:"secret,omitempty"`
 
+	// ServiceAccountToken authenticates with a projected token of the collector service account,
+	// sent as bearer token instead of the `token` key of the output secret.
+	// The token is rotated by the kubelet before it expires.
+	// Only supported by http, loki and elasticsearch outputs of the vector collector.
+	//
+	// +optional
+	ServiceAccountToken *ServiceAccountToken `json:"serviceAccountToken,omitempty"`
+
 	// Limit of the aggregated logs to this output from any given
 	// collector deployment. The total log flow from an individual collector
 	// deployment to this output cannot exceed the limit.  Generally, one
@@ -216,6 +246,25 @@ type OutputSpec struct {
 	//
 	// +optional
 	Limit *LimitSpec `json:"limit,omitempty"`
+
+	// Tuning parameters for delivering records to this output, only supported by the vector collector.
+	//
+	// +optional
+	Tuning *OutputTuningSpec `json:"tuning,omitempty"`
+}
+
+// ServiceAccountToken configures the projected service account token of an output
+type ServiceAccountToken struct {
+	// Audience of the token, validated by the receiver. Defaults to the audience of the API server.
+	//
+	// +optional
+	Audience string `json:"audience,omitempty"`
+
+	// ExpirationSeconds is the requested validity of the token. Defaults to 3600.
+	//
+	// +kubebuilder:validation:Minimum:=600
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
 }
 
 // OutputTLSSpec contains options for TLS connections that are agnostic to the output type.
@@ -227,6 +276,75 @@ type OutputTLSSpec struct {
 
 	// TLSSecurityProfile is the security profile to apply to the output connection
 	TLSSecurityProfile *openshiftv1.TLSSecurityProfile `json:"securityProfile,omitempty"`
+
+	// CA is the certificate authority used to validate the server certificate.
+	// Overrides the `ca-bundle.crt` key of the output secret.
+	//
+	// +optional
+	CA *ValueReference `json:"ca,omitempty"`
+
+	// Certificate is the client certificate for mutual authentication. Requires `key`.
+	// Overrides the `tls.crt` key of the output secret.
+	//
+	// +optional
+	Certificate *ValueReference `json:"certificate,omitempty"`
+
+	// Key is the private key of the client certificate. Requires `certificate`.
+	// Overrides the `tls.key` key of the output secret.
+	//
+	// +optional
+	Key *SecretReference `json:"key,omitempty"`
+
+	// KeyPassphrase is the passphrase to decode an encrypted private key.
+	// Overrides the `passphrase` key of the output secret.
+	//
+	// +optional
+	KeyPassphrase *SecretReference `json:"keyPassphrase,omitempty"`
+}
+
+// HasReferences is true if any TLS value is referenced independently of the output secret
+func (tls *OutputTLSSpec) HasReferences() bool {
+	return tls != nil && (tls.CA != nil || tls.Certificate != nil || tls.Key != nil || tls.KeyPassphrase != nil)
+}
+
+// ValueReference is a key of a ConfigMap or Secret in the namespace of the ClusterLogForwarder.
+// Exactly one of `configMapName` or `secretName` is required.
+type ValueReference struct {
+	// Key of the value in the ConfigMap or Secret
+	//
+	// +required
+	Key string `json:"key"`
+
+	// ConfigMapName is the name of the ConfigMap holding the value
+	//
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+
+	// SecretName is the name of the Secret holding the value
+	//
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// SecretReference is a key of a Secret in the namespace of the ClusterLogForwarder.
+type SecretReference struct {
+	// Key of the value in the Secret
+	//
+	// +required
+	Key string `json:"key"`
+
+	// SecretName is the name of the Secret holding the value
+	//
+	// +required
+	SecretName string `json:"secretName"`
+}
+
+// ValueReference of the secret key, nil if the reference is nil
+func (ref *SecretReference) ValueReference() *ValueReference {
+	if ref == nil {
+		return nil
+	}
+	return &ValueReference{Key: ref.Key, SecretName: ref.SecretName}
 }
 
 // OutputSecretSpec is a secret reference containing name only, no namespace.
@@ -248,7 +366,7 @@ type FilterSpec struct {
 
 	// Type of filter.
 	//
-	// +kubebuilder:validation:Enum:=kubeAPIAudit
+	// +kubebuilder:validation:Enum:=kubeAPIAudit;drop;prune;remap
 	// +required
 	Type string `json:"type"`
 
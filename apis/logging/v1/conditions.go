@@ -58,6 +58,10 @@ const (
 	ReasonUnused status.ConditionReason = "Unused"
 	// Connecting object is unready because a connection is in progress.
 	ReasonConnecting status.ConditionReason = "Connecting"
+	// Exposed receiver input is reachable outside the cluster at the address of the message.
+	ReasonExposed status.ConditionReason = "Exposed"
+	// UnboundedLabels output is ready but labels records with keys that have an unbounded number of values.
+	ReasonUnboundedLabels status.ConditionReason = "UnboundedLabels"
 
 	ValidationFailureReason status.ConditionReason = "ValidationFailure"
 )
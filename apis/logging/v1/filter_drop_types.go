@@ -0,0 +1,36 @@
+package v1
+
+// DropTest is a set of conditions that are ANDed together.
+// A record matches the test if all of its conditions match.
+type DropTest struct {
+	// DropConditions is a list of conditions, all of which must match for the test to pass.
+	//
+	// +kubebuilder:validation:MinItems:=1
+	// +required
+	DropConditions []DropCondition `json:"test"`
+}
+
+// DropCondition matches the value of a single record field against a regular expression.
+type DropCondition struct {
+	// Field is a dot delimited path to a field in the log record. It must start with a `.`.
+	// Path segments can contain alpha-numeric characters and underscores (a-zA-Z0-9_).
+	// Segments containing other characters must be quoted.
+	//
+	// Examples: `.kubernetes.namespace_name`, `.log_type`, `.kubernetes.labels."app.kubernetes.io/name"`
+	//
+	// +required
+	Field string `json:"field"`
+
+	// Matches is a regular expression, the condition matches if the field value matches the expression.
+	// Exactly one of matches or notMatches must be set.
+	//
+	// +optional
+	Matches string `json:"matches,omitempty"`
+
+	// NotMatches is a regular expression, the condition matches if the field value does not match the expression.
+	// A missing field is treated as an empty string.
+	// Exactly one of matches or notMatches must be set.
+	//
+	// +optional
+	NotMatches string `json:"notMatches,omitempty"`
+}
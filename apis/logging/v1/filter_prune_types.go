@@ -0,0 +1,21 @@
+package v1
+
+// PruneFilterSpec removes fields from log records.
+//
+// Field paths are dot delimited and must start with a `.`, see [DropCondition] for the path syntax.
+// The fields `.log_type` and `.message` are required by the collector and outputs, they can not be pruned.
+//
+// If both `in` and `notIn` are set, `notIn` is applied first: all fields not listed are removed,
+// then the fields listed in `in` are removed from what remains.
+type PruneFilterSpec struct {
+	// In is a list of field paths to remove from the log record.
+	//
+	// +optional
+	In []string `json:"in,omitempty"`
+
+	// NotIn is a list of field paths to keep, all other fields are removed from the log record.
+	// The list must include `.log_type` and `.message`.
+	//
+	// +optional
+	NotIn []string `json:"notIn,omitempty"`
+}
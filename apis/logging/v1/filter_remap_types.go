@@ -0,0 +1,24 @@
+package v1
+
+// RemapFilterSpec is a user supplied program in the [Vector Remap Language] (VRL).
+//
+// The program is applied to each record passing through the pipeline, it can modify the record
+// or drop it using `abort`.
+// The program is checked when the ClusterLogForwarder is validated:
+//
+//   - Brackets, braces, parentheses and string literals must be balanced.
+//   - Functions that access the collector environment or secrets (e.g. `get_env_var`) are not allowed.
+//   - The program must not be larger than 16KiB.
+//
+// A program that fails these checks invalidates the filter and any pipeline that references it.
+// Note these checks do not guarantee the program compiles, errors in a program that passes them
+// are only reported by the collector.
+//
+// [Vector Remap Language]: https://vector.dev/docs/reference/vrl/
+type RemapFilterSpec struct {
+	// Source is the VRL program.
+	//
+	// +kubebuilder:validation:MinLength:=1
+	// +required
+	Source string `json:"source"`
+}
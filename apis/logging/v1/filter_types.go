@@ -6,6 +6,9 @@ package v1
 // Filter type constants, must match JSON tags of FilterTypeSpec fields.
 const (
 	FilterKubeAPIAudit = "kubeAPIAudit"
+	FilterDrop         = "drop"
+	FilterPrune        = "prune"
+	FilterRemap        = "remap"
 )
 
 // FilterTypeSpec is a union of filter specification types.
@@ -14,5 +17,22 @@ type FilterTypeSpec struct {
 	// +optional
 	KubeAPIAudit *KubeAPIAudit `json:"kubeAPIAudit,omitempty"`
 
-	// NOTE more filter types expected in future, for example filtering on record fields (e.g. level).
+	// Drop is a list of tests applied to each record.
+	// The record is dropped if any test passes; a test passes if all of its conditions match.
+	// Records that pass no test are forwarded unchanged.
+	//
+	// +optional
+	Drop []DropTest `json:"drop,omitempty"`
+
+	// Prune removes fields from each record, either the listed fields (`in`) or all but the listed fields (`notIn`).
+	//
+	// +optional
+	Prune *PruneFilterSpec `json:"prune,omitempty"`
+
+	// Remap applies a user supplied VRL program to each record.
+	//
+	// +optional
+	Remap *RemapFilterSpec `json:"remap,omitempty"`
+
+	// NOTE more filter types expected in future.
 }
@@ -8,6 +8,21 @@ const (
 	ReceiverTypeSyslog = "syslog"
 
 	FormatKubeAPIAudit = "kubeAPIAudit" // Log events in k8s list format, e.g. API audit log events.
+	FormatJSON         = "json"         // A JSON object or an array of JSON objects per request
+	FormatNDJSON       = "ndjson"       // Newline delimited JSON objects
+	FormatText         = "text"         // Plain text, one log event per line
+
+	HTTPReceiverAuthBasic       = "basic"       // Basic authentication with the username and password of a secret
+	HTTPReceiverAuthBearerToken = "bearerToken" // Bearer token authentication with the token of a secret
+
+	SyslogReceiverProtocolTCP = "tcp" // Plain text syslog over TCP
+	SyslogReceiverProtocolUDP = "udp" // Plain text syslog over UDP
+	SyslogReceiverProtocolTLS = "tls" // Syslog over TCP with TLS
+
+	ReceiverExposureClusterIP    = "ClusterIP"    // Reachable inside the cluster only
+	ReceiverExposureNodePort     = "NodePort"     // Reachable on a port of each node
+	ReceiverExposureLoadBalancer = "LoadBalancer" // Reachable at the address of a load balancer
+	ReceiverExposureRoute        = "Route"        // Reachable through an OpenShift passthrough route, requires TLS
 )
 
 // ReceiverSpec is a union of input Receiver types.
@@ -23,6 +38,52 @@ type ReceiverSpec struct {
 
 	// The ReceiverTypeSpec that handles particular parameters
 	*ReceiverTypeSpec `json:",inline"`
+
+	// Exposure of the receiver outside the cluster, defaults to the service of the input
+	// being reachable inside the cluster only.
+	//
+	// +optional
+	Exposure *ReceiverExposure `json:"exposure,omitempty"`
+}
+
+// ReceiverExposure defines how the service of a receiver input is reachable.
+type ReceiverExposure struct {
+	// Type of the exposure: a ClusterIP, NodePort or LoadBalancer service, or an OpenShift
+	// passthrough Route to the service. Route requires a TLS receiver.
+	//
+	// +kubebuilder:validation:Enum:=ClusterIP;NodePort;LoadBalancer;Route
+	// +kubebuilder:default:=ClusterIP
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// Host of the route, defaults to the host generated by the router. Requires type `Route`.
+	//
+	// +optional
+	Host string `json:"host,omitempty"`
+
+	// TLSSecretName is the name of the secret in the namespace of the ClusterLogForwarder holding the `tls.crt`
+	// and `tls.key` served by the receiver instead of the certificate of the input service. The certificate
+	// must be valid for the address clients connect to. Required for a TLS receiver exposed outside the cluster.
+	//
+	// +optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+}
+
+// GetTLSSecretName returns the name of the secret of the certificate served by the receiver
+// if it is exposed outside the cluster
+func (r *ReceiverSpec) GetTLSSecretName() string {
+	if r.Exposure == nil || r.GetExposureType() == ReceiverExposureClusterIP {
+		return ""
+	}
+	return r.Exposure.TLSSecretName
+}
+
+// GetExposureType returns the exposure type of the receiver, defaults to ClusterIP
+func (r *ReceiverSpec) GetExposureType() string {
+	if r.Exposure == nil || r.Exposure.Type == "" {
+		return ReceiverExposureClusterIP
+	}
+	return r.Exposure.Type
 }
 
 type ReceiverTypeSpec struct {
@@ -41,9 +102,79 @@ type HTTPReceiver struct {
 
 	// Format is the format of incoming log data.
 	//
-	// +kubebuilder:validation:Enum:=kubeAPIAudit
+	// +kubebuilder:validation:Enum:=kubeAPIAudit;json;ndjson;text
 	// +required
 	Format string `json:"format"`
+
+	// LogType is the log_type of the received records, defaults to `application`.
+	// Records of format `kubeAPIAudit` are always of log type `audit`.
+	//
+	// +kubebuilder:validation:Pattern:=`^[a-z][a-z0-9_-]{0,62}$`
+	// +optional
+	LogType string `json:"logType,omitempty"`
+
+	// FieldMapping moves fields of JSON records to the message, timestamp and level of the record.
+	// Not supported for formats `kubeAPIAudit` and `text`.
+	//
+	// +optional
+	FieldMapping *HTTPReceiverFieldMapping `json:"fieldMapping,omitempty"`
+
+	// ClientCA is the certificate authority used to verify the certificates of HTTP clients.
+	// Enables mutual TLS.
+	//
+	// +optional
+	ClientCA *ValueReference `json:"clientCA,omitempty"`
+
+	// Authentication of the HTTP clients with the credentials of a secret
+	//
+	// +optional
+	Authentication *HTTPReceiverAuthentication `json:"authentication,omitempty"`
+}
+
+// HTTPReceiverAuthentication authenticates HTTP clients with the credentials of a secret
+type HTTPReceiverAuthentication struct {
+	// Type of the authentication: `basic` requires the `username` and `password` keys of the secret,
+	// `bearerToken` requires the `token` key. Posts without valid basic auth credentials are rejected,
+	// the records of posts without the bearer token are accepted and dropped.
+	//
+	// +kubebuilder:validation:Enum:=basic;bearerToken
+	// +required
+	Type string `json:"type"`
+
+	// SecretName is the name of the secret in the namespace of the ClusterLogForwarder holding the credentials
+	//
+	// +required
+	SecretName string `json:"secretName"`
+}
+
+// HTTPReceiverFieldMapping are the paths of the fields of received JSON records, e.g. `.log.msg`
+type HTTPReceiverFieldMapping struct {
+	// Message is the path of the field moved to `message`
+	//
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Timestamp is the path of the field moved to `@timestamp`. The field is parsed as RFC3339 timestamp,
+	// fields with other values are not moved.
+	//
+	// +optional
+	Timestamp string `json:"timestamp,omitempty"`
+
+	// Level is the path of the field moved to `level`
+	//
+	// +optional
+	Level string `json:"level,omitempty"`
+}
+
+// GetLogType returns the log type of the received records, defaults to application
+func (r *HTTPReceiver) GetLogType() string {
+	switch {
+	case r.Format == FormatKubeAPIAudit:
+		return InputNameAudit
+	case r.LogType == "":
+		return InputNameApplication
+	}
+	return r.LogType
 }
 
 // SyslogReceiver receives logs from rsyslog
@@ -54,4 +185,41 @@ type SyslogReceiver struct {
 	// +kubebuilder:validation:Maximum:=65535
 	// +optional
 	Port int32 `json:"port"`
+
+	// Protocol the Receiver listens on: plain text over tcp or udp, or tcp with tls.
+	//
+	// +kubebuilder:validation:Enum:=tcp;udp;tls
+	// +kubebuilder:default:=tls
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+
+	// ClientCA is the certificate authority used to verify the certificates of syslog clients.
+	// Enables mutual TLS, requires protocol `tls`.
+	//
+	// +optional
+	ClientCA *ValueReference `json:"clientCA,omitempty"`
+
+	// LogType is the log_type of the received records, defaults to `infrastructure`.
+	// Outputs that route on the log type (e.g. elasticsearch, cloudwatch, lokiStack) only support
+	// `application`, `infrastructure` and `audit`.
+	//
+	// +kubebuilder:validation:Pattern:=`^[a-z][a-z0-9_-]{0,62}$`
+	// +optional
+	LogType string `json:"logType,omitempty"`
+}
+
+// GetProtocol returns the protocol of the receiver, defaults to tls
+func (r *SyslogReceiver) GetProtocol() string {
+	if r.Protocol == "" {
+		return SyslogReceiverProtocolTLS
+	}
+	return r.Protocol
+}
+
+// GetLogType returns the log type of the received records, defaults to infrastructure
+func (r *SyslogReceiver) GetLogType() string {
+	if r.LogType == "" {
+		return InputNameInfrastructure
+	}
+	return r.LogType
 }
@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// OutputTuningSpec tunes how the collector delivers records to an output.
+type OutputTuningSpec struct {
+	// Buffer configures how records are buffered before they are sent to the output.
+	//
+	// +optional
+	Buffer *OutputBufferSpec `json:"buffer,omitempty"`
+
+	// MaxBatchBytes is the maximum size in bytes of a batch of records sent to the output.
+	//
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MaxBatchBytes *int64 `json:"maxBatchBytes,omitempty"`
+
+	// MaxBatchEvents is the maximum number of records in a batch sent to the output.
+	//
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MaxBatchEvents *int64 `json:"maxBatchEvents,omitempty"`
+
+	// BatchTimeoutSecs is the maximum age in seconds of a batch before it is sent to the output.
+	//
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	BatchTimeoutSecs *int64 `json:"batchTimeoutSecs,omitempty"`
+
+	// MinRetryDurationSecs is the time in seconds to wait before the first retry of a failed request.
+	// The wait time grows with each retry up to MaxRetryDurationSecs.
+	//
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MinRetryDurationSecs *int64 `json:"minRetryDurationSecs,omitempty"`
+
+	// MaxRetryDurationSecs is the maximum time in seconds to wait between retries of a failed request.
+	//
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MaxRetryDurationSecs *int64 `json:"maxRetryDurationSecs,omitempty"`
+
+	// Concurrency of requests to the output: `adaptive`, `none` for one request at a time,
+	// or a fixed number of requests in flight.
+	//
+	// +kubebuilder:validation:Pattern:=`^(adaptive|none|[1-9][0-9]*)$`
+	// +optional
+	Concurrency string `json:"concurrency,omitempty"`
+
+	// Compression codec for requests to the output.
+	// Supported codecs depend on the output type: `none`, `gzip`, `zlib`, `zstd`, `snappy` or `lz4`.
+	//
+	// +kubebuilder:validation:Enum:=none;gzip;zlib;zstd;snappy;lz4
+	// +optional
+	Compression string `json:"compression,omitempty"`
+}
+
+// HasBatch returns true if any batch tuning is set
+func (t *OutputTuningSpec) HasBatch() bool {
+	return t != nil && (t.MaxBatchBytes != nil || t.MaxBatchEvents != nil || t.BatchTimeoutSecs != nil)
+}
+
+// HasRequest returns true if any request tuning is set
+func (t *OutputTuningSpec) HasRequest() bool {
+	return t != nil && (t.MinRetryDurationSecs != nil || t.MaxRetryDurationSecs != nil || t.Concurrency != "")
+}
+
+const (
+	BufferTypeMemory = "memory"
+	BufferTypeDisk   = "disk"
+
+	BufferWhenFullBlock      = "block"
+	BufferWhenFullDropNewest = "drop_newest"
+)
+
+// MinDiskBufferSize is the smallest disk buffer supported by the collector
+var MinDiskBufferSize = resource.MustParse("268435488")
+
+// OutputBufferSpec configures the buffer of an output.
+//
+// Disk buffers are stored on the node under the collector data directory and survive collector restarts.
+type OutputBufferSpec struct {
+	// Type of buffer: `memory` (default) or `disk`.
+	//
+	// +kubebuilder:validation:Enum:=memory;disk
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// MaxEvents is the maximum number of records held by a memory buffer.
+	// Only valid for `memory` buffers.
+	//
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MaxEvents *int64 `json:"maxEvents,omitempty"`
+
+	// MaxSize is the maximum size of a disk buffer, required for `disk` buffers.
+	// The minimum is 268435488 bytes (256Mi + 32).
+	//
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+
+	// WhenFull is the behavior when the buffer is full:
+	//
+	// `drop_newest` (default) drops records that arrive when the buffer is full.
+	//
+	// `block` stops reading new records until there is room in the buffer, logs are not lost
+	// unless the source files are rotated away before they are read.
+	//
+	// +kubebuilder:validation:Enum:=block;drop_newest
+	// +optional
+	WhenFull string `json:"whenFull,omitempty"`
+}
@@ -14,6 +14,10 @@ const (
 	OutputTypeGoogleCloudLogging = "googleCloudLogging"
 	OutputTypeSplunk             = "splunk"
 	OutputTypeHttp               = "http"
+	OutputTypeOTLP               = "otlp"
+	OutputTypeS3                 = "s3"
+	OutputTypeAzureMonitor       = "azureMonitor"
+	OutputTypeLokiStack          = "lokiStack"
 )
 
 // OutputTypeSpec is a union of optional additional configuration specific to an
@@ -37,6 +41,14 @@ type OutputTypeSpec struct {
 	Splunk *Splunk `json:"splunk,omitempty"`
 	// +optional
 	Http *Http `json:"http,omitempty"`
+	// +optional
+	OTLP *OTLP `json:"otlp,omitempty"`
+	// +optional
+	S3 *S3 `json:"s3,omitempty"`
+	// +optional
+	AzureMonitor *AzureMonitor `json:"azureMonitor,omitempty"`
+	// +optional
+	LokiStack *LokiStack `json:"lokiStack,omitempty"`
 }
 
 // Cloudwatch provides configuration for the output type `cloudwatch`
@@ -64,6 +76,63 @@ type Cloudwatch struct {
 	GroupPrefix *string `json:"groupPrefix,omitempty"`
 }
 
+// S3 provides configuration for the output type `s3`
+//
+// Note: the s3 output recognizes the same keys in the Secret as the cloudwatch output:
+//
+//	`aws_secret_access_key`: AWS secret access key.
+//	`aws_access_key_id`: AWS secret access key ID.
+//
+// Or for sts-enabled clusters `credentials` or `role_arn` key specifying a properly formatted role arn.
+// The `url` is optional and overrides the S3 endpoint, e.g. for an S3 compatible object store.
+// Only supported by the vector collector.
+type S3 struct {
+	// Region of the bucket
+	// +required
+	Region string `json:"region,omitempty"`
+
+	// Bucket is the name of the S3 bucket to write objects to
+	// +required
+	Bucket string `json:"bucket,omitempty"`
+
+	// KeyPrefix is a template for the prefix of the object keys. Record fields are referenced as
+	// {.path.to.field} with an optional fallback value {.path.to.field||"none"} used when the field
+	// is missing, otherwise "unknown" is used. strftime specifiers are rendered from the record timestamp.
+	//
+	// The default prefix is `{.log_type}/%Y/%m/%d/`
+	//
+	// +kubebuilder:validation:Optional
+	// +optional
+	KeyPrefix string `json:"keyPrefix,omitempty"`
+}
+
+// AzureMonitor provides configuration for the output type `azureMonitor`
+//
+// Note: the azureMonitor output requires the following key in the Secret:
+//
+//	`shared_key`: the primary or secondary key of the Log Analytics workspace.
+//
+// Only supported by the vector collector.
+type AzureMonitor struct {
+	// CustomerId is the unique identifier of the Log Analytics workspace
+	// +required
+	CustomerId string `json:"customerId,omitempty"`
+
+	// LogType is the record type of the data being submitted. Can only contain letters, numbers
+	// and underscores (_), and may not exceed 100 characters.
+	// The Log Analytics table name is the log type with the suffix `_CL`.
+	//
+	// +kubebuilder:validation:Pattern:="^[a-zA-Z0-9_]{1,100}$"
+	// +required
+	LogType string `json:"logType,omitempty"`
+
+	// Host is an alternative ingestion host for sovereign clouds, e.g. `ods.opinsights.azure.us`.
+	// The default is `ods.opinsights.azure.com`
+	//
+	// +optional
+	Host string `json:"host,omitempty"`
+}
+
 // LogGroupByType defines a fixed strategy type
 type LogGroupByType string
 
@@ -162,15 +231,76 @@ type Syslog struct {
 	//
 	// +optional
 	MsgID string `json:"msgID,omitempty"`
+
+	// StructuredData adds an RFC5424 STRUCTURED-DATA element to the records.
+	//
+	// Only supported by the vector collector with rfc5424, not supported with AddLogSource.
+	//
+	// +optional
+	StructuredData *SyslogStructuredData `json:"structuredData,omitempty"`
+
+	// Framing of the records sent over tcp or tls:
+	//  - newline: each record is terminated by a newline
+	//  - octetCounting: each record is prefixed by its length in bytes and a space (RFC6587), requires rfc5424
+	//
+	// If unspecified, the framing of the collector is used.
+	// Only supported by the vector collector.
+	//
+	// +kubebuilder:validation:Enum:=newline;octetCounting
+	// +optional
+	Framing string `json:"framing,omitempty"`
+
+	// MaxMessageSize is the maximum size in bytes of the MSG part of records sent over udp,
+	// longer messages are truncated. Without PayloadKey the MSG part is the JSON encoded record.
+	// The syslog header is not included.
+	//
+	// Only supported by the vector collector.
+	//
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MaxMessageSize int `json:"maxMessageSize,omitempty"`
+}
+
+const (
+	SyslogFramingNewline       = "newline"
+	SyslogFramingOctetCounting = "octetCounting"
+)
+
+// SyslogStructuredData is an RFC5424 STRUCTURED-DATA element
+type SyslogStructuredData struct {
+	// ID is the SD-ID of the element. IDs that are not registered with IANA must be of the form
+	// `name@<private enterprise number>`, e.g. `openshift@2312`
+	//
+	// +required
+	ID string `json:"id"`
+
+	// Params maps the PARAM-NAMEs of the element to their values.
+	// A value of the form `$.abc.xyz` is taken from the corresponding field of the record,
+	// e.g. `namespace: $.kubernetes.namespace_name`. Params of missing fields are omitted.
+	//
+	// +required
+	Params map[string]string `json:"params"`
 }
 
 // Kafka provides optional extra properties for `type: kafka`
 type Kafka struct {
 	// Topic specifies the target topic to send logs to.
 	//
+	// The topic may be a template that references record fields, enclosed in braces, with a fallback
+	// value used when the field is missing, e.g. `app-{.kubernetes.namespace_name||"none"}`.
+	// Templates are only supported by the vector collector.
+	//
 	// +optional
 	Topic string `json:"topic,omitempty"`
 
+	// Key specifies a template for the message key, e.g. `{.kubernetes.pod_name||"none"}`.
+	// Messages with the same key are written to the same partition.
+	// Fields are referenced as in Topic and require a fallback value.
+	// Only supported by the vector collector.
+	//
+	// +optional
+	Key string `json:"key,omitempty"`
+
 	// Brokers specifies the list of broker endpoints of a Kafka cluster.
 	// The list represents only the initial set used by the collector's Kafka client for the
 	// first connection only. The collector's Kafka client fetches constantly an updated list
@@ -179,6 +309,58 @@ type Kafka struct {
 	//
 	// +optional
 	Brokers []string `json:"brokers,omitempty"`
+
+	// SASL enables SASL authentication with the `username` and `password` keys of the output secret.
+	//
+	// +optional
+	SASL *KafkaSASL `json:"sasl,omitempty"`
+
+	// RequiredAcks is the number of acknowledgements the partition leader must receive before a
+	// message is considered sent: 0 (none), 1 (the leader only) or -1 (all in-sync replicas).
+	// Only supported by the vector collector.
+	//
+	// +kubebuilder:validation:Minimum:=-1
+	// +kubebuilder:validation:Maximum:=1
+	// +optional
+	RequiredAcks *int32 `json:"requiredAcks,omitempty"`
+
+	// Compression is the compression codec of produced messages.
+	// The `tuning.compression` of the output takes precedence when set.
+	// Only supported by the vector collector.
+	//
+	// +kubebuilder:validation:Enum:=none;gzip;snappy;lz4;zstd
+	// +optional
+	Compression string `json:"compression,omitempty"`
+
+	// MaxMessageBytes is the maximum size of a produced message.
+	// Only supported by the vector collector.
+	//
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MaxMessageBytes *int64 `json:"maxMessageBytes,omitempty"`
+
+	// Idempotent enables the idempotent producer, which writes retried messages exactly once and in order.
+	// It requires acknowledgement by all in-sync replicas, RequiredAcks must be -1 when set.
+	// Only supported by the vector collector.
+	//
+	// +optional
+	Idempotent bool `json:"idempotent,omitempty"`
+}
+
+const (
+	KafkaSASLMechanismPlain       = "PLAIN"
+	KafkaSASLMechanismScramSHA256 = "SCRAM-SHA-256"
+	KafkaSASLMechanismScramSHA512 = "SCRAM-SHA-512"
+)
+
+// KafkaSASL configures SASL authentication of a Kafka output.
+// The credentials are read from the `username` and `password` keys of the output secret.
+type KafkaSASL struct {
+	// Mechanism is the SASL mechanism used to authenticate. Defaults to PLAIN.
+	//
+	// +kubebuilder:validation:Enum:=PLAIN;SCRAM-SHA-256;SCRAM-SHA-512
+	// +optional
+	Mechanism string `json:"mechanism,omitempty"`
 }
 
 // FluentdForward does not provide additional fields, but note that
@@ -199,6 +381,91 @@ type Elasticsearch struct {
 	// +kubebuilder:validation:Minimum:=6
 	// +optional
 	Version int `json:"version,omitempty"`
+
+	// BulkMode is the bulk API mode used to write records. Must be one of:
+	//  - index - Write records with the index action, replacing existing documents with the same ID
+	//  - create - Write records with the create action. Default
+	//  - data_stream - Write records to the data stream spec'd by DataStream
+	//
+	// Only supported by the vector collector.
+	//
+	// +kubebuilder:validation:Enum:=index;create;data_stream
+	// +optional
+	BulkMode string `json:"bulkMode,omitempty"`
+
+	// DataStream configures the target data stream when BulkMode is data_stream
+	//
+	// +optional
+	DataStream *ElasticsearchDataStream `json:"dataStream,omitempty"`
+
+	// Pipeline is the name of an ingest pipeline applied to the records.
+	// Only supported by the vector collector.
+	//
+	// +optional
+	Pipeline string `json:"pipeline,omitempty"`
+
+	// IDField is the record field used as document ID, e.g. `.viaq_msg_id`.
+	// Records without the field are given a random ID.
+	// Only supported by the vector collector.
+	//
+	// +optional
+	IDField string `json:"idField,omitempty"`
+
+	// Auth selects how requests are authenticated.
+	// Defaults to basic authentication with the `username` and `password` keys of the output secret, if any.
+	// Only supported by the vector collector.
+	//
+	// +optional
+	Auth *ElasticsearchAuth `json:"auth,omitempty"`
+}
+
+const (
+	ElasticsearchAuthTypeAWS    = "aws"
+	ElasticsearchAuthTypeAPIKey = "apiKey"
+)
+
+// ElasticsearchAuth configures the authentication of an Elasticsearch or OpenSearch output
+type ElasticsearchAuth struct {
+	// Type of authentication. Must be one of:
+	//  - aws - Sign requests with AWS SigV4 using the `aws_access_key_id` and `aws_secret_access_key`,
+	//    or the `role_arn` keys of the output secret, e.g. for Amazon OpenSearch Service
+	//  - apiKey - Send the `api_key` key of the output secret in an `ApiKey` authorization header,
+	//    e.g. for Elastic Cloud
+	//
+	// +kubebuilder:validation:Enum:=aws;apiKey
+	// +required
+	Type string `json:"type"`
+
+	// Region of the AWS service. Required for type aws
+	//
+	// +optional
+	Region string `json:"region,omitempty"`
+}
+
+const (
+	ElasticsearchBulkModeIndex      = "index"
+	ElasticsearchBulkModeCreate     = "create"
+	ElasticsearchBulkModeDataStream = "data_stream"
+)
+
+// ElasticsearchDataStream names the data stream `<type>-<dataset>-<namespace>` records are written to.
+// Each part may be a template that references record fields, enclosed in braces, with a fallback
+// value used when the field is missing, e.g. `{.kubernetes.namespace_name||"none"}`
+type ElasticsearchDataStream struct {
+	// Type of the data stream. Defaults to `logs`
+	//
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// Dataset of the data stream. Defaults to `{.log_type||"unknown"}`
+	//
+	// +optional
+	Dataset string `json:"dataset,omitempty"`
+
+	// Namespace of the data stream. Defaults to `default`
+	//
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // ElasticsearchStructuredSpec is spec related to structured log changes to determine the elasticsearch index
@@ -248,6 +515,51 @@ type Loki struct {
 	//
 	// +optional
 	LabelKeys []string `json:"labelKeys,omitempty"`
+
+	// PodLabelKeys is a list of pod label key globs, e.g. `app.kubernetes.io/*`. The pod labels with a matching key
+	// are added as Loki labels named `kubernetes_labels_<key>` with illegal characters in the key replaced by '_'.
+	//
+	// Only supported by the vector collector.
+	//
+	// +optional
+	PodLabelKeys []string `json:"podLabelKeys,omitempty"`
+
+	// MaxLabelValues is the maximum number of distinct values of each Loki label added by PodLabelKeys.
+	// Records with further values are labeled `__overflow__` instead. Defaults to 100.
+	//
+	// The limit applies to each collector pod and is kept until the collector restarts,
+	// so a label can have up to MaxLabelValues values per node in Loki.
+	//
+	// Only supported by the vector collector.
+	//
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MaxLabelValues int `json:"maxLabelValues,omitempty"`
+
+	// AllowUnboundedLabels permits PodLabelKeys that select pod labels with an unbounded number of values,
+	// e.g. the `pod-template-hash` pod label. LabelKeys with unbounded values, e.g. `kubernetes.pod_name`,
+	// are accepted with a warning in the output status.
+	//
+	// +optional
+	AllowUnboundedLabels bool `json:"allowUnboundedLabels,omitempty"`
+}
+
+// LokiStack provides configuration for the output type `lokiStack`
+//
+// Logs are forwarded to the `application`, `infrastructure` or `audit` tenant of the LokiStack gateway
+// matching their log type. The gateway is verified with the service CA and the collector authenticates
+// with the token of its service account, unless the output secret provides a `token`.
+// The service account must be allowed to create logs for the tenants.
+type LokiStack struct {
+	// Name of the LokiStack resource.
+	//
+	// +required
+	Name string `json:"name"`
+
+	// Namespace of the LokiStack resource. Defaults to the namespace of the ClusterLogForwarder.
+	//
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // GoogleCloudLogging provides configuration for sending logs to Google Cloud Logging.
@@ -291,6 +603,46 @@ type Splunk struct {
 	// If IndexKey && IndexName are not specified, the default index defined within Splunk is used.
 	// +optional
 	IndexName string `json:"indexName,omitempty"`
+
+	// Source is the template of the Splunk event `source`, e.g. `{.kubernetes.namespace_name||"none"}:{.kubernetes.container_name||"none"}`.
+	// Fields are written as `{.path.to.field||"fallback"}`.
+	// If Source is not specified, Splunk derives the source from the HEC token.
+	// +optional
+	Source string `json:"source,omitempty"`
+
+	// SourceType is the template of the Splunk event `sourcetype`, e.g. `openshift:{.log_type||"unknown"}`.
+	// If SourceType is not specified, the default sourcetype of the HEC token is used.
+	// +optional
+	SourceType string `json:"sourceType,omitempty"`
+
+	// Host is the template of the Splunk event `host`, e.g. `{.hostname||"unknown"}`.
+	// If Host is not specified, Splunk sets the host from the connection of the collector.
+	// +optional
+	Host string `json:"host,omitempty"`
+
+	// Acknowledgements configures HEC indexer acknowledgements.
+	// If Acknowledgements is not specified, acknowledgements are used when the HEC token requires them.
+	// +optional
+	Acknowledgements *SplunkAcknowledgements `json:"acknowledgements,omitempty"`
+}
+
+// SplunkAcknowledgements configures HEC indexer acknowledgements. Events are retried until Splunk acknowledges
+// they have been indexed. Requires indexer acknowledgement to be enabled for the HEC token.
+type SplunkAcknowledgements struct {
+	// Enabled enables indexer acknowledgements.
+	// +required
+	Enabled bool `json:"enabled"`
+
+	// QueryInterval is the interval in seconds between queries of the acknowledgement status. Defaults to 10.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	QueryInterval int `json:"queryInterval,omitempty"`
+
+	// RetryLimit is the number of times the acknowledgement status of an event is queried before it is
+	// considered failed. Defaults to 30.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	RetryLimit int `json:"retryLimit,omitempty"`
 }
 
 // Http provided configuration for sending json encoded logs to a generic http endpoint.
@@ -318,4 +670,49 @@ type Http struct {
 	// +kubebuilder:default:viaq
 	// +optional
 	Schema string `json:"schema,omitempty"`
+
+	// Format of the request body and its Content-Type:
+	//
+	//   * `json`: a JSON array of records, `application/json`
+	//   * `ndjson`: newline delimited JSON records, `application/x-ndjson`
+	//   * `text`: newline delimited values of the TextField of the records, `text/plain`
+	//
+	// If Format is not set, records are sent as a JSON array with the Content-Type header of Headers, if any.
+	// If Format is set, the collector sets the Content-Type and a Content-Type header must match the format.
+	// Only supported by the vector collector.
+	//
+	// +kubebuilder:validation:Enum:=json;ndjson;text
+	// +optional
+	Format string `json:"format,omitempty"`
+
+	// TextField is the record field sent by the `text` format, e.g. `.message`. Defaults to `.message`.
+	// Values that are not strings are JSON encoded.
+	//
+	// +optional
+	TextField string `json:"textField,omitempty"`
+}
+
+const (
+	HttpFormatJSON   = "json"
+	HttpFormatNDJSON = "ndjson"
+	HttpFormatText   = "text"
+)
+
+// OTLP provides optional extra properties for output type `otlp`.
+//
+// Records of all log types are sent as OpenTelemetry Protocol (OTLP) log records
+// encoded as JSON over HTTP. Kubernetes metadata is sent as resource attributes.
+// If the `url` has no path, `/v1/logs` is used.
+//
+// Note: the otlp output recognizes the same secret keys as the http output:
+// `username`, `password` and `token` for authentication and the common TLS keys.
+// Only supported by the vector collector.
+type OTLP struct {
+	// Headers specify optional headers to be sent with the request
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Timeout specifies the request timeout in seconds. If not set, 10secs is used.
+	// +optional
+	Timeout int `json:"timeout,omitempty"`
 }
@@ -80,6 +80,21 @@ func (in *Audit) DeepCopy() *Audit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureMonitor) DeepCopyInto(out *AzureMonitor) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureMonitor.
+func (in *AzureMonitor) DeepCopy() *AzureMonitor {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureMonitor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cloudwatch) DeepCopyInto(out *Cloudwatch) {
 	*out = *in
@@ -579,10 +594,55 @@ func (in *CuratorStatus) DeepCopy() *CuratorStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DropCondition) DeepCopyInto(out *DropCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DropCondition.
+func (in *DropCondition) DeepCopy() *DropCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(DropCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DropTest) DeepCopyInto(out *DropTest) {
+	*out = *in
+	if in.DropConditions != nil {
+		in, out := &in.DropConditions, &out.DropConditions
+		*out = make([]DropCondition, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DropTest.
+func (in *DropTest) DeepCopy() *DropTest {
+	if in == nil {
+		return nil
+	}
+	out := new(DropTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Elasticsearch) DeepCopyInto(out *Elasticsearch) {
 	*out = *in
 	out.ElasticsearchStructuredSpec = in.ElasticsearchStructuredSpec
+	if in.DataStream != nil {
+		in, out := &in.DataStream, &out.DataStream
+		*out = new(ElasticsearchDataStream)
+		**out = **in
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(ElasticsearchAuth)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Elasticsearch.
@@ -595,6 +655,21 @@ func (in *Elasticsearch) DeepCopy() *Elasticsearch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchAuth) DeepCopyInto(out *ElasticsearchAuth) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchAuth.
+func (in *ElasticsearchAuth) DeepCopy() *ElasticsearchAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in ElasticsearchClusterConditions) DeepCopyInto(out *ElasticsearchClusterConditions) {
 	{
@@ -616,6 +691,21 @@ func (in ElasticsearchClusterConditions) DeepCopy() ElasticsearchClusterConditio
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchDataStream) DeepCopyInto(out *ElasticsearchDataStream) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchDataStream.
+func (in *ElasticsearchDataStream) DeepCopy() *ElasticsearchDataStream {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchDataStream)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ElasticsearchSpec) DeepCopyInto(out *ElasticsearchSpec) {
 	*out = *in
@@ -801,6 +891,23 @@ func (in *FilterTypeSpec) DeepCopyInto(out *FilterTypeSpec) {
 		*out = new(KubeAPIAudit)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Drop != nil {
+		in, out := &in.Drop, &out.Drop
+		*out = make([]DropTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Prune != nil {
+		in, out := &in.Prune, &out.Prune
+		*out = new(PruneFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Remap != nil {
+		in, out := &in.Remap, &out.Remap
+		*out = new(RemapFilterSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterTypeSpec.
@@ -1027,6 +1134,21 @@ func (in *GoogleCloudLogging) DeepCopy() *GoogleCloudLogging {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPReceiver) DeepCopyInto(out *HTTPReceiver) {
 	*out = *in
+	if in.FieldMapping != nil {
+		in, out := &in.FieldMapping, &out.FieldMapping
+		*out = new(HTTPReceiverFieldMapping)
+		**out = **in
+	}
+	if in.ClientCA != nil {
+		in, out := &in.ClientCA, &out.ClientCA
+		*out = new(ValueReference)
+		**out = **in
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(HTTPReceiverAuthentication)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPReceiver.
@@ -1039,6 +1161,36 @@ func (in *HTTPReceiver) DeepCopy() *HTTPReceiver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPReceiverAuthentication) DeepCopyInto(out *HTTPReceiverAuthentication) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPReceiverAuthentication.
+func (in *HTTPReceiverAuthentication) DeepCopy() *HTTPReceiverAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPReceiverAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPReceiverFieldMapping) DeepCopyInto(out *HTTPReceiverFieldMapping) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPReceiverFieldMapping.
+func (in *HTTPReceiverFieldMapping) DeepCopy() *HTTPReceiverFieldMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPReceiverFieldMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Http) DeepCopyInto(out *Http) {
 	*out = *in
@@ -1149,6 +1301,21 @@ func (in *Kafka) DeepCopyInto(out *Kafka) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SASL != nil {
+		in, out := &in.SASL, &out.SASL
+		*out = new(KafkaSASL)
+		**out = **in
+	}
+	if in.RequiredAcks != nil {
+		in, out := &in.RequiredAcks, &out.RequiredAcks
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxMessageBytes != nil {
+		in, out := &in.MaxMessageBytes, &out.MaxMessageBytes
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kafka.
@@ -1161,6 +1328,21 @@ func (in *Kafka) DeepCopy() *Kafka {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSASL) DeepCopyInto(out *KafkaSASL) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSASL.
+func (in *KafkaSASL) DeepCopy() *KafkaSASL {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSASL)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KibanaSpec) DeepCopyInto(out *KibanaSpec) {
 	*out = *in
@@ -1421,6 +1603,11 @@ func (in *Loki) DeepCopyInto(out *Loki) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PodLabelKeys != nil {
+		in, out := &in.PodLabelKeys, &out.PodLabelKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Loki.
@@ -1433,6 +1620,21 @@ func (in *Loki) DeepCopy() *Loki {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiStack) DeepCopyInto(out *LokiStack) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStack.
+func (in *LokiStack) DeepCopy() *LokiStack {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiStack)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LokiStackStoreSpec) DeepCopyInto(out *LokiStackStoreSpec) {
 	*out = *in
@@ -1506,6 +1708,53 @@ func (in *OCPConsoleSpec) DeepCopy() *OCPConsoleSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLP) DeepCopyInto(out *OTLP) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLP.
+func (in *OTLP) DeepCopy() *OTLP {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputBufferSpec) DeepCopyInto(out *OutputBufferSpec) {
+	*out = *in
+	if in.MaxEvents != nil {
+		in, out := &in.MaxEvents, &out.MaxEvents
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputBufferSpec.
+func (in *OutputBufferSpec) DeepCopy() *OutputBufferSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputBufferSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OutputDefaults) DeepCopyInto(out *OutputDefaults) {
 	*out = *in
@@ -1555,11 +1804,21 @@ func (in *OutputSpec) DeepCopyInto(out *OutputSpec) {
 		*out = new(OutputSecretSpec)
 		**out = **in
 	}
+	if in.ServiceAccountToken != nil {
+		in, out := &in.ServiceAccountToken, &out.ServiceAccountToken
+		*out = new(ServiceAccountToken)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Limit != nil {
 		in, out := &in.Limit, &out.Limit
 		*out = new(LimitSpec)
 		**out = **in
 	}
+	if in.Tuning != nil {
+		in, out := &in.Tuning, &out.Tuning
+		*out = new(OutputTuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputSpec.
@@ -1580,6 +1839,26 @@ func (in *OutputTLSSpec) DeepCopyInto(out *OutputTLSSpec) {
 		*out = new(configv1.TLSSecurityProfile)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(ValueReference)
+		**out = **in
+	}
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(ValueReference)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.KeyPassphrase != nil {
+		in, out := &in.KeyPassphrase, &out.KeyPassphrase
+		*out = new(SecretReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputTLSSpec.
@@ -1592,13 +1871,58 @@ func (in *OutputTLSSpec) DeepCopy() *OutputTLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputTuningSpec) DeepCopyInto(out *OutputTuningSpec) {
+	*out = *in
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(OutputBufferSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxBatchBytes != nil {
+		in, out := &in.MaxBatchBytes, &out.MaxBatchBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxBatchEvents != nil {
+		in, out := &in.MaxBatchEvents, &out.MaxBatchEvents
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BatchTimeoutSecs != nil {
+		in, out := &in.BatchTimeoutSecs, &out.BatchTimeoutSecs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinRetryDurationSecs != nil {
+		in, out := &in.MinRetryDurationSecs, &out.MinRetryDurationSecs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxRetryDurationSecs != nil {
+		in, out := &in.MaxRetryDurationSecs, &out.MaxRetryDurationSecs
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputTuningSpec.
+func (in *OutputTuningSpec) DeepCopy() *OutputTuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputTuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OutputTypeSpec) DeepCopyInto(out *OutputTypeSpec) {
 	*out = *in
 	if in.Syslog != nil {
 		in, out := &in.Syslog, &out.Syslog
 		*out = new(Syslog)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.FluentdForward != nil {
 		in, out := &in.FluentdForward, &out.FluentdForward
@@ -1608,7 +1932,7 @@ func (in *OutputTypeSpec) DeepCopyInto(out *OutputTypeSpec) {
 	if in.Elasticsearch != nil {
 		in, out := &in.Elasticsearch, &out.Elasticsearch
 		*out = new(Elasticsearch)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Kafka != nil {
 		in, out := &in.Kafka, &out.Kafka
@@ -1640,6 +1964,26 @@ func (in *OutputTypeSpec) DeepCopyInto(out *OutputTypeSpec) {
 		*out = new(Http)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(OTLP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3)
+		**out = **in
+	}
+	if in.AzureMonitor != nil {
+		in, out := &in.AzureMonitor, &out.AzureMonitor
+		*out = new(AzureMonitor)
+		**out = **in
+	}
+	if in.LokiStack != nil {
+		in, out := &in.LokiStack, &out.LokiStack
+		*out = new(LokiStack)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputTypeSpec.
@@ -1738,6 +2082,46 @@ func (in *ProxySpec) DeepCopy() *ProxySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PruneFilterSpec) DeepCopyInto(out *PruneFilterSpec) {
+	*out = *in
+	if in.In != nil {
+		in, out := &in.In, &out.In
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotIn != nil {
+		in, out := &in.NotIn, &out.NotIn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PruneFilterSpec.
+func (in *PruneFilterSpec) DeepCopy() *PruneFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PruneFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReceiverExposure) DeepCopyInto(out *ReceiverExposure) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverExposure.
+func (in *ReceiverExposure) DeepCopy() *ReceiverExposure {
+	if in == nil {
+		return nil
+	}
+	out := new(ReceiverExposure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReceiverSpec) DeepCopyInto(out *ReceiverSpec) {
 	*out = *in
@@ -1746,6 +2130,11 @@ func (in *ReceiverSpec) DeepCopyInto(out *ReceiverSpec) {
 		*out = new(ReceiverTypeSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Exposure != nil {
+		in, out := &in.Exposure, &out.Exposure
+		*out = new(ReceiverExposure)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverSpec.
@@ -1764,12 +2153,12 @@ func (in *ReceiverTypeSpec) DeepCopyInto(out *ReceiverTypeSpec) {
 	if in.HTTP != nil {
 		in, out := &in.HTTP, &out.HTTP
 		*out = new(HTTPReceiver)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Syslog != nil {
 		in, out := &in.Syslog, &out.Syslog
 		*out = new(SyslogReceiver)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -1783,6 +2172,21 @@ func (in *ReceiverTypeSpec) DeepCopy() *ReceiverTypeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemapFilterSpec) DeepCopyInto(out *RemapFilterSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemapFilterSpec.
+func (in *RemapFilterSpec) DeepCopy() *RemapFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RemapFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetentionPoliciesSpec) DeepCopyInto(out *RetentionPoliciesSpec) {
 	*out = *in
@@ -1883,6 +2287,56 @@ func (in *Routes) DeepCopy() *Routes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3) DeepCopyInto(out *S3) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3.
+func (in *S3) DeepCopy() *S3 {
+	if in == nil {
+		return nil
+	}
+	out := new(S3)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretReference) DeepCopyInto(out *SecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReference.
+func (in *SecretReference) DeepCopy() *SecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountToken) DeepCopyInto(out *ServiceAccountToken) {
+	*out = *in
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountToken.
+func (in *ServiceAccountToken) DeepCopy() *ServiceAccountToken {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Splunk) DeepCopyInto(out *Splunk) {
 	*out = *in
@@ -1891,6 +2345,11 @@ func (in *Splunk) DeepCopyInto(out *Splunk) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Acknowledgements != nil {
+		in, out := &in.Acknowledgements, &out.Acknowledgements
+		*out = new(SplunkAcknowledgements)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Splunk.
@@ -1903,9 +2362,29 @@ func (in *Splunk) DeepCopy() *Splunk {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplunkAcknowledgements) DeepCopyInto(out *SplunkAcknowledgements) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplunkAcknowledgements.
+func (in *SplunkAcknowledgements) DeepCopy() *SplunkAcknowledgements {
+	if in == nil {
+		return nil
+	}
+	out := new(SplunkAcknowledgements)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Syslog) DeepCopyInto(out *Syslog) {
 	*out = *in
+	if in.StructuredData != nil {
+		in, out := &in.StructuredData, &out.StructuredData
+		*out = new(SyslogStructuredData)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Syslog.
@@ -1921,6 +2400,11 @@ func (in *Syslog) DeepCopy() *Syslog {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyslogReceiver) DeepCopyInto(out *SyslogReceiver) {
 	*out = *in
+	if in.ClientCA != nil {
+		in, out := &in.ClientCA, &out.ClientCA
+		*out = new(ValueReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogReceiver.
@@ -1933,6 +2417,43 @@ func (in *SyslogReceiver) DeepCopy() *SyslogReceiver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogStructuredData) DeepCopyInto(out *SyslogStructuredData) {
+	*out = *in
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogStructuredData.
+func (in *SyslogStructuredData) DeepCopy() *SyslogStructuredData {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogStructuredData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValueReference) DeepCopyInto(out *ValueReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValueReference.
+func (in *ValueReference) DeepCopy() *ValueReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ValueReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VisualizationSpec) DeepCopyInto(out *VisualizationSpec) {
 	*out = *in
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	routev1 "github.com/openshift/api/route/v1"
 	"github.com/openshift/cluster-logging-operator/internal/collector"
 	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
@@ -82,6 +83,11 @@ func (r *ReconcileForwarder) Reconcile(ctx context.Context, request ctrl.Request
 
 	// Fetch the ClusterLogForwarder instance
 	instance, err, status := loader.FetchClusterLogForwarder(r.Client, request.NamespacedName.Namespace, request.NamespacedName.Name, true, func() logging.ClusterLogging { return *cl })
+	// Remember the last reported degraded state so the warning event is only sent when it changes
+	var wasDegraded *logging.Condition
+	if degraded := instance.Status.Conditions.GetCondition(logging.ConditionDegraded); degraded != nil && degraded.IsTrue() {
+		wasDegraded = degraded.DeepCopy()
+	}
 	if status != nil {
 		instance.Status = *status
 	}
@@ -126,6 +132,10 @@ func (r *ReconcileForwarder) Reconcile(ctx context.Context, request ctrl.Request
 				r.Recorder.Event(&instance, "Normal", string(logging.CondReady.Type), "ClusterLogForwarder is valid")
 			}
 		}
+		if degraded := instance.Status.Conditions.GetCondition(logging.ConditionDegraded); degraded != nil && degraded.IsTrue() &&
+			(wasDegraded == nil || wasDegraded.Message != degraded.Message) {
+			r.Recorder.Event(&instance, "Warning", string(logging.ConditionDegraded), degraded.Message)
+		}
 	}
 
 	if result, err := r.updateStatus(&instance); err != nil {
@@ -186,6 +196,7 @@ func (r *ReconcileForwarder) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&rbacv1.RoleBinding{}).
 		Owns(&corev1.Secret{}).
 		Owns(&corev1.Service{}).
+		Owns(&routev1.Route{}).
 		Owns(&corev1.ServiceAccount{}).
 		Owns(&v1.ServiceMonitor{}).
 		Complete(r)
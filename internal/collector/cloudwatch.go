@@ -10,13 +10,13 @@ import (
 	"path"
 )
 
-// Add volumes and env vars if output type is cloudwatch and role is found in the secret
-func addWebIdentityForCloudwatch(collector *v1.Container, podSpec *v1.PodSpec, forwarderSpec logging.ClusterLogForwarderSpec, secrets map[string]*v1.Secret, collectorType logging.LogCollectionType) {
+// Add volumes and env vars if output type is cloudwatch, s3 or elasticsearch with aws auth and role is found in the secret
+func addWebIdentityForAWS(collector *v1.Container, podSpec *v1.PodSpec, forwarderSpec logging.ClusterLogForwarderSpec, secrets map[string]*v1.Secret, collectorType logging.LogCollectionType) {
 	if secrets == nil {
 		return
 	}
 	for _, o := range forwarderSpec.Outputs {
-		if o.Type == logging.OutputTypeCloudwatch {
+		if o.Type == logging.OutputTypeCloudwatch || o.Type == logging.OutputTypeS3 || isElasticsearchAWSAuth(o) {
 			secret := secrets[o.Name]
 			if security.HasAwsRoleArnKey(secret) || security.HasAwsCredentialsKey(secret) {
 				log.V(3).Info("Found sts key in secret")
@@ -74,7 +74,7 @@ func AddWebIdentityTokenEnvVars(collector *v1.Container, output logging.OutputSp
 	collector.Env = append(collector.Env,
 		v1.EnvVar{
 			Name:  constants.AWSRegionEnvVarKey,
-			Value: output.Cloudwatch.Region,
+			Value: awsRegion(output),
 		},
 		v1.EnvVar{
 			Name:  constants.AWSRoleArnEnvVarKey,
@@ -90,3 +90,21 @@ func AddWebIdentityTokenEnvVars(collector *v1.Container, output logging.OutputSp
 		},
 	)
 }
+
+// awsRegion of a cloudwatch, s3 or elasticsearch with aws auth output
+func awsRegion(output logging.OutputSpec) string {
+	switch {
+	case output.Cloudwatch != nil:
+		return output.Cloudwatch.Region
+	case output.S3 != nil:
+		return output.S3.Region
+	case isElasticsearchAWSAuth(output):
+		return output.Elasticsearch.Auth.Region
+	}
+	return ""
+}
+
+func isElasticsearchAWSAuth(output logging.OutputSpec) bool {
+	return output.Type == logging.OutputTypeElasticsearch && output.Elasticsearch != nil &&
+		output.Elasticsearch.Auth != nil && output.Elasticsearch.Auth.Type == logging.ElasticsearchAuthTypeAWS
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/collector/vector"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/factory"
+	vectorcommon "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 )
 
@@ -163,21 +164,30 @@ func (f *Factory) NewPodSpec(trustedCABundle *v1.ConfigMap, forwarderSpec loggin
 		)
 	}
 
+	servingSecrets := f.receiverServingSecrets(forwarderSpec)
 	for _, receiverInput := range receiverInputs {
+		secretName := receiverInput
+		if name, found := servingSecrets[receiverInput]; found {
+			secretName = name
+		}
 		podSpec.Volumes = append(podSpec.Volumes,
-			v1.Volume{Name: receiverInput, VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: receiverInput}}},
+			v1.Volume{Name: receiverInput, VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: secretName}}},
 		)
 	}
 
 	secretNames := AddSecretVolumes(podSpec, forwarderSpec)
+	configMapNames := AddConfigMapVolumes(podSpec, forwarderSpec)
 
 	collector := f.NewCollectorContainer(secretNames, clusterID, receiverInputs)
+	AddConfigMapVolumeMounts(collector, configMapNames)
+	addTLSKeyPassphraseEnvVars(collector, forwarderSpec)
 
 	addTrustedCABundle(collector, podSpec, trustedCABundle, f.ResourceNames.CaTrustBundle)
 
 	f.Visit(collector, podSpec, f.ResourceNames, namespace)
 
-	addWebIdentityForCloudwatch(collector, podSpec, forwarderSpec, f.Secrets, f.CollectorType)
+	addWebIdentityForAWS(collector, podSpec, forwarderSpec, f.Secrets, f.CollectorType)
+	addServiceAccountTokens(collector, podSpec, forwarderSpec)
 
 	podSpec.Containers = []v1.Container{
 		*collector,
@@ -250,15 +260,32 @@ func (f *Factory) ReconcileInputServices(er record.EventRecorder, k8sClient clie
 		var listenPort int32
 		serviceName := f.ResourceNames.GenerateInputServiceName(input.Name)
 		if input.Receiver != nil && input.Receiver.ReceiverTypeSpec != nil {
+			protocol := v1.ProtocolTCP
 			if logging.IsHttpReceiver(&input) {
 				listenPort = input.Receiver.HTTP.Port
 			}
 			if logging.IsSyslogReceiver(&input) {
 				listenPort = input.Receiver.Syslog.Port
+				if input.Receiver.Syslog.GetProtocol() == logging.SyslogReceiverProtocolUDP {
+					protocol = v1.ProtocolUDP
+				}
+			}
+			exposure := input.Receiver.GetExposureType()
+			serviceType := v1.ServiceTypeClusterIP
+			switch exposure {
+			case logging.ReceiverExposureNodePort:
+				serviceType = v1.ServiceTypeNodePort
+			case logging.ReceiverExposureLoadBalancer:
+				serviceType = v1.ServiceTypeLoadBalancer
 			}
-			if err := network.ReconcileInputService(er, k8sClient, namespace, serviceName, selectorComponent, serviceName, listenPort, listenPort, input.Receiver.Type, f.isDaemonset, owner, visitors); err != nil {
+			if err := network.ReconcileInputService(er, k8sClient, namespace, serviceName, selectorComponent, serviceName, listenPort, listenPort, protocol, serviceType, input.Receiver.Type, f.isDaemonset, owner, visitors); err != nil {
 				return err
 			}
+			if exposure == logging.ReceiverExposureRoute {
+				if err := network.ReconcileInputRoute(er, k8sClient, namespace, serviceName, input.Receiver.Exposure.Host, listenPort, input.Receiver.Type, owner, visitors); err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
@@ -286,6 +313,26 @@ func AddSecretVolumes(podSpec *v1.PodSpec, pipelineSpec logging.ClusterLogForwar
 		if o.Secret != nil && o.Secret.Name != "" {
 			unique.Insert(o.Secret.Name)
 		}
+		if o.TLS.HasReferences() {
+			for _, ref := range []*logging.ValueReference{o.TLS.CA, o.TLS.Certificate} {
+				if ref != nil && ref.SecretName != "" {
+					unique.Insert(ref.SecretName)
+				}
+			}
+			if o.TLS.Key != nil {
+				unique.Insert(o.TLS.Key.SecretName)
+			}
+		}
+	}
+	for _, ref := range receiverClientCAs(pipelineSpec) {
+		if ref.SecretName != "" {
+			unique.Insert(ref.SecretName)
+		}
+	}
+	for _, input := range pipelineSpec.Inputs {
+		if logging.IsHttpReceiver(&input) && input.Receiver.HTTP != nil && input.Receiver.HTTP.Authentication != nil {
+			unique.Insert(input.Receiver.HTTP.Authentication.SecretName)
+		}
 	}
 	secretNames := unique.List()
 	for _, name := range secretNames {
@@ -294,6 +341,103 @@ func AddSecretVolumes(podSpec *v1.PodSpec, pipelineSpec logging.ClusterLogForwar
 	return secretNames
 }
 
+// AddConfigMapVolumes adds configmap volumes to the pod spec for the unique set of configmaps referenced by outputs
+// and returns the list of the configmap names
+func AddConfigMapVolumes(podSpec *v1.PodSpec, pipelineSpec logging.ClusterLogForwarderSpec) []string {
+	unique := sets.NewString()
+	for _, o := range pipelineSpec.Outputs {
+		if o.TLS.HasReferences() {
+			for _, ref := range []*logging.ValueReference{o.TLS.CA, o.TLS.Certificate} {
+				if ref != nil && ref.ConfigMapName != "" {
+					unique.Insert(ref.ConfigMapName)
+				}
+			}
+		}
+	}
+	for _, ref := range receiverClientCAs(pipelineSpec) {
+		if ref.ConfigMapName != "" {
+			unique.Insert(ref.ConfigMapName)
+		}
+	}
+	configMapNames := unique.List()
+	for _, name := range configMapNames {
+		podSpec.Volumes = append(podSpec.Volumes, v1.Volume{
+			Name: configMapVolumeName(name),
+			VolumeSource: v1.VolumeSource{
+				ConfigMap: &v1.ConfigMapVolumeSource{
+					LocalObjectReference: v1.LocalObjectReference{
+						Name: name,
+					},
+				},
+			},
+		})
+	}
+	return configMapNames
+}
+
+// receiverServingSecrets are the secrets of the certificates served by receivers exposed outside the cluster
+// instead of the certificate of the input service, by the name of the input service
+func (f *Factory) receiverServingSecrets(pipelineSpec logging.ClusterLogForwarderSpec) map[string]string {
+	secrets := map[string]string{}
+	for _, input := range pipelineSpec.Inputs {
+		if input.Receiver != nil && input.Receiver.GetTLSSecretName() != "" {
+			secrets[f.ResourceNames.GenerateInputServiceName(input.Name)] = input.Receiver.GetTLSSecretName()
+		}
+	}
+	return secrets
+}
+
+// receiverClientCAs are the client CA references of the http receivers and the syslog receivers with tls
+func receiverClientCAs(pipelineSpec logging.ClusterLogForwarderSpec) []logging.ValueReference {
+	refs := []logging.ValueReference{}
+	for _, input := range pipelineSpec.Inputs {
+		switch {
+		case logging.IsHttpReceiver(&input) && input.Receiver.HTTP != nil && input.Receiver.HTTP.ClientCA != nil:
+			refs = append(refs, *input.Receiver.HTTP.ClientCA)
+		case logging.IsSyslogReceiver(&input) && input.Receiver.Syslog != nil && input.Receiver.Syslog.ClientCA != nil &&
+			input.Receiver.Syslog.GetProtocol() == logging.SyslogReceiverProtocolTLS:
+			refs = append(refs, *input.Receiver.Syslog.ClientCA)
+		}
+	}
+	return refs
+}
+
+// AddConfigMapVolumeMounts to the collector container
+func AddConfigMapVolumeMounts(collector *v1.Container, configMapNames []string) {
+	for _, name := range configMapNames {
+		collector.VolumeMounts = append(collector.VolumeMounts, v1.VolumeMount{Name: configMapVolumeName(name), ReadOnly: true, MountPath: OutputConfigMapPath(name)})
+	}
+}
+
+func OutputConfigMapPath(configMapName string) string {
+	return path.Join(constants.CollectorConfigMapsDir, configMapName)
+}
+
+// configMapVolumeName avoids a clash with the volume of a secret with the same name
+func configMapVolumeName(configMapName string) string {
+	return "configmap-" + configMapName
+}
+
+// addTLSKeyPassphraseEnvVars adds the referenced TLS key passphrases of outputs as env vars of the collector
+func addTLSKeyPassphraseEnvVars(collector *v1.Container, pipelineSpec logging.ClusterLogForwarderSpec) {
+	for _, o := range pipelineSpec.Outputs {
+		if o.TLS == nil || o.TLS.KeyPassphrase == nil {
+			continue
+		}
+		collector.Env = append(collector.Env, v1.EnvVar{
+			Name: vectorcommon.TLSKeyPassphraseEnvVar(o.Name),
+			ValueFrom: &v1.EnvVarSource{
+				SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{
+						Name: o.TLS.KeyPassphrase.SecretName,
+					},
+					Key: o.TLS.KeyPassphrase.Key,
+				},
+			},
+		})
+	}
+}
+
 func AddSecurityContextTo(container *v1.Container) *v1.Container {
 	container.SecurityContext = &v1.SecurityContext{
 		Capabilities: &v1.Capabilities{
@@ -702,5 +702,325 @@ var _ = Describe("Factory#NewPodSpec Add Cloudwatch STS Resources", func() {
 
 			})
 		})
+		Context("when an s3 output has a secret containing a role_arn key", func() {
+			It("should find the AWS web identity env vars and volume for the s3 region", func() {
+				s3Outputs := []logging.OutputSpec{
+					{
+						Type: logging.OutputTypeS3,
+						Name: "cw",
+						OutputTypeSpec: logging.OutputTypeSpec{
+							S3: &logging.S3{
+								Region: "eu-west-3",
+								Bucket: "archive",
+							},
+						},
+						Secret: &logging.OutputSecretSpec{
+							Name: "my-secret",
+						},
+					},
+				}
+				factory.Secrets = map[string]*v1.Secret{
+					s3Outputs[0].Name: {
+						Data: map[string][]byte{
+							"role_arn": []byte(roleArn),
+						},
+					},
+				}
+				podSpec := *factory.NewPodSpec(nil, logging.ClusterLogForwarderSpec{
+					Outputs:   s3Outputs,
+					Pipelines: pipelines,
+				}, "1234", "", tls.GetClusterTLSProfileSpec(nil), nil, constants.OpenshiftNS)
+				collector := podSpec.Containers[0]
+
+				Expect(collector.Env).To(IncludeEnvVar(v1.EnvVar{
+					Name:  constants.AWSRegionEnvVarKey,
+					Value: "eu-west-3",
+				}))
+				Expect(collector.Env).To(IncludeEnvVar(v1.EnvVar{
+					Name:  constants.AWSRoleArnEnvVarKey,
+					Value: roleArn,
+				}))
+				Expect(collector.VolumeMounts).To(ContainElement(HaveField("Name", constants.AWSWebIdentityTokenName)))
+			})
+		})
+		Context("when an elasticsearch output with aws auth has a secret containing a role_arn key", func() {
+			It("should find the AWS web identity env vars for the auth region", func() {
+				esOutputs := []logging.OutputSpec{
+					{
+						Type: logging.OutputTypeElasticsearch,
+						Name: "cw",
+						URL:  "https://search-logs.us-west-2.es.amazonaws.com",
+						OutputTypeSpec: logging.OutputTypeSpec{
+							Elasticsearch: &logging.Elasticsearch{
+								Auth: &logging.ElasticsearchAuth{
+									Type:   logging.ElasticsearchAuthTypeAWS,
+									Region: "us-west-2",
+								},
+							},
+						},
+						Secret: &logging.OutputSecretSpec{
+							Name: "my-secret",
+						},
+					},
+				}
+				factory.Secrets = map[string]*v1.Secret{
+					esOutputs[0].Name: {
+						Data: map[string][]byte{
+							"role_arn": []byte(roleArn),
+						},
+					},
+				}
+				podSpec := *factory.NewPodSpec(nil, logging.ClusterLogForwarderSpec{
+					Outputs:   esOutputs,
+					Pipelines: pipelines,
+				}, "1234", "", tls.GetClusterTLSProfileSpec(nil), nil, constants.OpenshiftNS)
+				collector := podSpec.Containers[0]
+
+				Expect(collector.Env).To(IncludeEnvVar(v1.EnvVar{
+					Name:  constants.AWSRegionEnvVarKey,
+					Value: "us-west-2",
+				}))
+				Expect(collector.Env).To(IncludeEnvVar(v1.EnvVar{
+					Name:  constants.AWSRoleArnEnvVarKey,
+					Value: roleArn,
+				}))
+			})
+		})
+	})
+})
+
+var _ = Describe("Factory#NewPodSpec Add TLS references", func() {
+	var (
+		factory *Factory
+		outputs = []logging.OutputSpec{
+			{
+				Type: logging.OutputTypeHttp,
+				Name: "my-http",
+				URL:  "https://my-http.example.com",
+				TLS: &logging.OutputTLSSpec{
+					CA:            &logging.ValueReference{Key: "ca-bundle.crt", ConfigMapName: "pki-ca"},
+					Certificate:   &logging.ValueReference{Key: "tls.crt", SecretName: "client-cert"},
+					Key:           &logging.SecretReference{Key: "tls.key", SecretName: "client-cert"},
+					KeyPassphrase: &logging.SecretReference{Key: "passphrase", SecretName: "client-passphrase"},
+				},
+			},
+		}
+	)
+	BeforeEach(func() {
+		factory = &Factory{
+			CollectorType: logging.LogCollectionTypeVector,
+			ImageName:     constants.VectorName,
+			Visit:         vector.CollectorVisitor,
+			ResourceNames: coreFactory.GenerateResourceNames(*runtime.NewClusterLogForwarder(constants.OpenshiftNS, constants.SingletonName)),
+		}
+	})
+
+	It("should mount the referenced configmaps and secrets and set the passphrase env var", func() {
+		podSpec := *factory.NewPodSpec(nil, logging.ClusterLogForwarderSpec{
+			Outputs: outputs,
+		}, "1234", "", tls.GetClusterTLSProfileSpec(nil), nil, constants.OpenshiftNS)
+		collector := podSpec.Containers[0]
+
+		Expect(podSpec.Volumes).To(ContainElement(v1.Volume{
+			Name:         "configmap-pki-ca",
+			VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "pki-ca"}}},
+		}))
+		Expect(podSpec.Volumes).To(ContainElement(v1.Volume{
+			Name:         "client-cert",
+			VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "client-cert"}},
+		}))
+		Expect(podSpec.Volumes).ToNot(ContainElement(HaveField("Name", "client-passphrase")))
+		Expect(collector.VolumeMounts).To(ContainElement(v1.VolumeMount{Name: "configmap-pki-ca", ReadOnly: true, MountPath: "/var/run/ocp-collector/configmaps/pki-ca"}))
+		Expect(collector.VolumeMounts).To(ContainElement(v1.VolumeMount{Name: "client-cert", ReadOnly: true, MountPath: "/var/run/ocp-collector/secrets/client-cert"}))
+		Expect(collector.Env).To(IncludeEnvVar(v1.EnvVar{
+			Name: "TLS_KEY_PASSPHRASE_6D792D68747470",
+			ValueFrom: &v1.EnvVarSource{
+				SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "client-passphrase"},
+					Key:                  "passphrase",
+				},
+			},
+		}))
+	})
+})
+
+var _ = Describe("Factory#NewPodSpec Add receiver client CAs and authentication secrets", func() {
+	It("should mount the referenced client CAs and the authentication secrets of receivers", func() {
+		factory := &Factory{
+			CollectorType: logging.LogCollectionTypeVector,
+			ImageName:     constants.VectorName,
+			Visit:         vector.CollectorVisitor,
+			ResourceNames: coreFactory.GenerateResourceNames(*runtime.NewClusterLogForwarder(constants.OpenshiftNS, constants.SingletonName)),
+		}
+		podSpec := *factory.NewPodSpec(nil, logging.ClusterLogForwarderSpec{
+			Inputs: []logging.InputSpec{
+				{
+					Name: "my-syslog",
+					Receiver: &logging.ReceiverSpec{
+						Type: logging.ReceiverTypeSyslog,
+						ReceiverTypeSpec: &logging.ReceiverTypeSpec{
+							Syslog: &logging.SyslogReceiver{
+								Port:     10514,
+								ClientCA: &logging.ValueReference{Key: "ca-bundle.crt", SecretName: "syslog-ca"},
+							},
+						},
+					},
+				},
+				{
+					Name: "my-http",
+					Receiver: &logging.ReceiverSpec{
+						Type: logging.ReceiverTypeHttp,
+						ReceiverTypeSpec: &logging.ReceiverTypeSpec{
+							HTTP: &logging.HTTPReceiver{
+								Port:     8443,
+								Format:   logging.FormatNDJSON,
+								ClientCA: &logging.ValueReference{Key: "ca-bundle.crt", ConfigMapName: "http-ca"},
+								Authentication: &logging.HTTPReceiverAuthentication{
+									Type:       logging.HTTPReceiverAuthBasic,
+									SecretName: "http-auth",
+								},
+							},
+						},
+					},
+				},
+			},
+		}, "1234", "", tls.GetClusterTLSProfileSpec(nil), nil, constants.OpenshiftNS)
+
+		Expect(podSpec.Volumes).To(ContainElement(v1.Volume{
+			Name:         "syslog-ca",
+			VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "syslog-ca"}},
+		}))
+		Expect(podSpec.Containers[0].VolumeMounts).To(ContainElement(v1.VolumeMount{Name: "syslog-ca", ReadOnly: true, MountPath: "/var/run/ocp-collector/secrets/syslog-ca"}))
+		Expect(podSpec.Containers[0].VolumeMounts).To(ContainElement(v1.VolumeMount{Name: "configmap-http-ca", ReadOnly: true, MountPath: "/var/run/ocp-collector/configmaps/http-ca"}))
+		Expect(podSpec.Containers[0].VolumeMounts).To(ContainElement(v1.VolumeMount{Name: "http-auth", ReadOnly: true, MountPath: "/var/run/ocp-collector/secrets/http-auth"}))
+	})
+})
+
+var _ = Describe("Factory#NewPodSpec Add receiver serving certificates", func() {
+	It("should mount the serving certificate of exposed receivers instead of the certificate of the input service", func() {
+		factory := &Factory{
+			CollectorType: logging.LogCollectionTypeVector,
+			ImageName:     constants.VectorName,
+			Visit:         vector.CollectorVisitor,
+			ResourceNames: coreFactory.GenerateResourceNames(*runtime.NewClusterLogForwarder(constants.OpenshiftNS, constants.SingletonName)),
+		}
+		exposed := factory.ResourceNames.GenerateInputServiceName("exposed")
+		internal := factory.ResourceNames.GenerateInputServiceName("internal")
+		httpReceiver := &logging.ReceiverTypeSpec{HTTP: &logging.HTTPReceiver{Port: 8443, Format: logging.FormatKubeAPIAudit}}
+		podSpec := *factory.NewPodSpec(nil, logging.ClusterLogForwarderSpec{
+			Inputs: []logging.InputSpec{
+				{
+					Name: "exposed",
+					Receiver: &logging.ReceiverSpec{
+						Type:             logging.ReceiverTypeHttp,
+						ReceiverTypeSpec: httpReceiver,
+						Exposure:         &logging.ReceiverExposure{Type: logging.ReceiverExposureRoute, TLSSecretName: "audit-serving-cert"},
+					},
+				},
+				{
+					Name: "internal",
+					Receiver: &logging.ReceiverSpec{
+						Type:             logging.ReceiverTypeHttp,
+						ReceiverTypeSpec: httpReceiver,
+					},
+				},
+			},
+		}, "1234", "", tls.GetClusterTLSProfileSpec(nil), []string{exposed, internal}, constants.OpenshiftNS)
+
+		Expect(podSpec.Volumes).To(ContainElement(v1.Volume{
+			Name:         exposed,
+			VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "audit-serving-cert"}},
+		}))
+		Expect(podSpec.Volumes).To(ContainElement(v1.Volume{
+			Name:         internal,
+			VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: internal}},
+		}))
+		Expect(podSpec.Containers[0].VolumeMounts).To(ContainElement(v1.VolumeMount{Name: exposed, ReadOnly: true, MountPath: "/etc/collector/receiver/" + exposed}))
+	})
+})
+
+var _ = Describe("Factory#NewPodSpec Add service account tokens", func() {
+	var (
+		factory *Factory
+		outputs = []logging.OutputSpec{
+			{
+				Type: logging.OutputTypeHttp,
+				Name: "my-http",
+				URL:  "https://my-http.example.com",
+				ServiceAccountToken: &logging.ServiceAccountToken{
+					Audience:          "my-receiver",
+					ExpirationSeconds: utils.GetPtr[int64](1800),
+				},
+			},
+		}
+	)
+	BeforeEach(func() {
+		factory = &Factory{
+			CollectorType: logging.LogCollectionTypeVector,
+			ImageName:     constants.VectorName,
+			Visit:         vector.CollectorVisitor,
+			ResourceNames: coreFactory.GenerateResourceNames(*runtime.NewClusterLogForwarder(constants.OpenshiftNS, constants.SingletonName)),
+		}
+	})
+
+	It("should mount a projected token with the audience and expiration of the output", func() {
+		podSpec := *factory.NewPodSpec(nil, logging.ClusterLogForwarderSpec{
+			Outputs: outputs,
+		}, "1234", "", tls.GetClusterTLSProfileSpec(nil), nil, constants.OpenshiftNS)
+		collector := podSpec.Containers[0]
+
+		Expect(podSpec.Volumes).To(ContainElement(v1.Volume{
+			Name: "sa-token-my-http",
+			VolumeSource: v1.VolumeSource{
+				Projected: &v1.ProjectedVolumeSource{
+					Sources: []v1.VolumeProjection{
+						{
+							ServiceAccountToken: &v1.ServiceAccountTokenProjection{
+								Audience:          "my-receiver",
+								ExpirationSeconds: utils.GetPtr[int64](1800),
+								Path:              "token",
+							},
+						},
+					},
+				},
+			},
+		}))
+		Expect(collector.VolumeMounts).To(ContainElement(v1.VolumeMount{Name: "sa-token-my-http", ReadOnly: true, MountPath: "/var/run/ocp-collector/serviceaccount/sa-token-my-http"}))
+	})
+
+	It("should mount a projected token for a LokiStack output without a secret", func() {
+		podSpec := *factory.NewPodSpec(nil, logging.ClusterLogForwarderSpec{
+			Outputs: []logging.OutputSpec{
+				{
+					Type:           logging.OutputTypeLokiStack,
+					Name:           "my-lokistack",
+					OutputTypeSpec: logging.OutputTypeSpec{LokiStack: &logging.LokiStack{Name: "logging-loki"}},
+				},
+				{
+					Type:           logging.OutputTypeLokiStack,
+					Name:           "other-lokistack",
+					OutputTypeSpec: logging.OutputTypeSpec{LokiStack: &logging.LokiStack{Name: "other-loki"}},
+					Secret:         &logging.OutputSecretSpec{Name: "other-token"},
+				},
+			},
+		}, "1234", "", tls.GetClusterTLSProfileSpec(nil), nil, constants.OpenshiftNS)
+		collector := podSpec.Containers[0]
+
+		Expect(podSpec.Volumes).To(ContainElement(v1.Volume{
+			Name: "sa-token-my-lokistack",
+			VolumeSource: v1.VolumeSource{
+				Projected: &v1.ProjectedVolumeSource{
+					Sources: []v1.VolumeProjection{
+						{
+							ServiceAccountToken: &v1.ServiceAccountTokenProjection{
+								Path: "token",
+							},
+						},
+					},
+				},
+			},
+		}))
+		Expect(collector.VolumeMounts).To(ContainElement(v1.VolumeMount{Name: "sa-token-my-lokistack", ReadOnly: true, MountPath: "/var/run/ocp-collector/serviceaccount/sa-token-my-lokistack"}))
+		Expect(collector.VolumeMounts).ToNot(ContainElement(HaveField("Name", "sa-token-other-lokistack")))
 	})
 })
@@ -0,0 +1,44 @@
+package collector
+
+import (
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
+	v1 "k8s.io/api/core/v1"
+)
+
+// addServiceAccountTokens adds a projected service account token volume for each output that authenticates
+// with a service account token
+func addServiceAccountTokens(collector *v1.Container, podSpec *v1.PodSpec, forwarderSpec logging.ClusterLogForwarderSpec) {
+	for _, o := range forwarderSpec.Outputs {
+		o := o // Don't bind range variable.
+		token := o.GetServiceAccountToken()
+		if token == nil {
+			continue
+		}
+		name := common.ServiceAccountTokenVolumeName(o.Name)
+		collector.VolumeMounts = append(collector.VolumeMounts,
+			v1.VolumeMount{
+				Name:      name,
+				ReadOnly:  true,
+				MountPath: common.ServiceAccountTokenDir(o.Name),
+			})
+		podSpec.Volumes = append(podSpec.Volumes,
+			v1.Volume{
+				Name: name,
+				VolumeSource: v1.VolumeSource{
+					Projected: &v1.ProjectedVolumeSource{
+						Sources: []v1.VolumeProjection{
+							{
+								ServiceAccountToken: &v1.ServiceAccountTokenProjection{
+									Audience:          token.Audience,
+									ExpirationSeconds: token.ExpirationSeconds,
+									Path:              constants.BearerTokenFileKey,
+								},
+							},
+						},
+					},
+				},
+			})
+	}
+}
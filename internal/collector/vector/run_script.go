@@ -1,5 +1,7 @@
 package vector
 
+import "github.com/openshift/cluster-logging-operator/internal/constants"
+
 // RunVectorScript is the run-vector.sh script for launching the Vector container process
 // will override content of /scripts/run-vector.sh in https://github.com/ViaQ/vector
 const RunVectorScript = `#!/bin/bash
@@ -7,6 +9,33 @@ const RunVectorScript = `#!/bin/bash
 VECTOR_DATA_DIR=%s
 echo "Creating the directory used for persisting Vector state $VECTOR_DATA_DIR"
 mkdir -p $VECTOR_DATA_DIR
+# Projected service account tokens are read when the configuration is loaded, reload it when they are rotated.
+# The kubelet replaces the ..data link of a projected volume when it rotates its token, so only the links of
+# the volumes are read every minute and the configuration is reloaded once for any rotated tokens
+SA_TOKENS_DIR=` + constants.CollectorServiceAccountTokensDir + `
+if [ -d "$SA_TOKENS_DIR" ]; then
+  VECTOR_PID=$$
+  (
+    declare -A versions
+    for dir in $SA_TOKENS_DIR/*/; do
+      versions[$dir]=$(readlink "$dir..data")
+    done
+    while sleep 60; do
+      rotated=""
+      for dir in "${!versions[@]}"; do
+        current=$(readlink "$dir..data")
+        if [ "$current" != "${versions[$dir]}" ]; then
+          versions[$dir]=$current
+          rotated="$rotated $(basename $dir)"
+        fi
+      done
+      if [ -n "$rotated" ]; then
+        echo "Service account tokens rotated:$rotated, reloading the Vector configuration"
+        kill -HUP $VECTOR_PID
+      fi
+    done
+  ) &
+fi
 echo "Starting Vector process..."
 exec /usr/bin/vector --config-toml /etc/vector/vector.toml
 `
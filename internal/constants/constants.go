@@ -42,6 +42,7 @@ const (
 	AWSWebIdentityTokenName     = "collector-sts-token"
 	AWSWebIdentityTokenMount    = "/var/run/secrets/openshift/serviceaccount" //nolint:gosec // default location for volume mount
 	AWSWebIdentityTokenFilePath = "token"                                     // file containing token relative to mount
+	ElasticsearchAPIKey         = "api_key"                                   // elasticsearch
 
 	AWSRegionEnvVarKey           = "AWS_REGION"
 	AWSRoleArnEnvVarKey          = "AWS_ROLE_ARN"
@@ -89,8 +90,10 @@ const (
 	PodSecurityLabelValue      = "privileged"
 	PodSecuritySyncLabel       = "security.openshift.io/scc.podSecurityLabelSync"
 	// Disable gosec linter, complains "possible hard-coded secret"
-	CollectorSecretsDir     = "/var/run/ocp-collector/secrets" //nolint:gosec
-	KibanaSessionSecretName = "kibana-session-secret"          //nolint:gosec
+	CollectorSecretsDir              = "/var/run/ocp-collector/secrets" //nolint:gosec
+	CollectorConfigMapsDir           = "/var/run/ocp-collector/configmaps"
+	CollectorServiceAccountTokensDir = "/var/run/ocp-collector/serviceaccount"
+	KibanaSessionSecretName          = "kibana-session-secret" //nolint:gosec
 
 	CollectorName               = "collector"
 	CollectorConfigSecretName   = "collector-config"
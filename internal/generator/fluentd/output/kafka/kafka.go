@@ -163,16 +163,14 @@ func SecurityConfig(o logging.OutputSpec, secret *corev1.Secret) []Element {
 			}
 			conf = append(conf, ca)
 		}
-		// Try the preferred and deprecated names.
-		_, ok := security.TryKeys(secret, constants.SASLEnable, constants.DeprecatedSaslOverSSL)
 		sasl := SASL{
-			SaslOverSSL: ok,
+			SaslOverSSL: o.Kafka != nil && o.Kafka.SASL != nil,
 		}
 		if security.HasPassphrase(secret) {
 			sasl.SaslKeyPassword = security.SecretPath(o.Secret.Name, constants.Passphrase)
 		}
-		if scramMechanism := security.GetFromSecret(secret, constants.SASLMechanisms); scramMechanism != "" {
-			sasl.ScramMechanism = scramMechanism
+		if sasl.SaslOverSSL && o.Kafka.SASL.Mechanism != "" {
+			sasl.ScramMechanism = o.Kafka.SASL.Mechanism
 		}
 		conf = append(conf, sasl)
 	}
@@ -1,7 +1,6 @@
 package kafka
 
 import (
-	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/helpers/security"
 	"testing"
@@ -230,6 +229,7 @@ var _ = Describe("Generate fluentd config", func() {
 						OutputTypeSpec: logging.OutputTypeSpec{
 							Kafka: &logging.Kafka{
 								Topic: "build_complete",
+								SASL:  &logging.KafkaSASL{},
 							},
 						},
 					},
@@ -238,10 +238,9 @@ var _ = Describe("Generate fluentd config", func() {
 			Secrets: map[string]*corev1.Secret{
 				"kafka-receiver": {
 					Data: map[string][]byte{
-						"username":      []byte("junk"),
-						"password":      []byte("junk"),
-						"passphrase":    []byte("-- passphrase --"),
-						"sasl_over_ssl": []byte("true"),
+						"username":   []byte("junk"),
+						"password":   []byte("junk"),
+						"passphrase": []byte("-- passphrase --"),
 					},
 				},
 			},
@@ -304,6 +303,7 @@ var _ = Describe("Generate fluentd config", func() {
 						OutputTypeSpec: logging.OutputTypeSpec{
 							Kafka: &logging.Kafka{
 								Topic: "build_complete",
+								SASL:  &logging.KafkaSASL{Mechanism: logging.KafkaSASLMechanismPlain},
 							},
 						},
 					},
@@ -312,10 +312,8 @@ var _ = Describe("Generate fluentd config", func() {
 			Secrets: map[string]*corev1.Secret{
 				"kafka-receiver": {
 					Data: map[string][]byte{
-						"username":               []byte("junk"),
-						"password":               []byte("junk"),
-						constants.SASLMechanisms: []byte("PLAIN"),
-						"sasl_over_ssl":          []byte("true"),
+						"username": []byte("junk"),
+						"password": []byte("junk"),
 					},
 				},
 			},
@@ -378,6 +376,7 @@ var _ = Describe("Generate fluentd config", func() {
 						OutputTypeSpec: logging.OutputTypeSpec{
 							Kafka: &logging.Kafka{
 								Topic: "build_complete",
+								SASL:  &logging.KafkaSASL{Mechanism: logging.KafkaSASLMechanismPlain},
 							},
 						},
 					},
@@ -386,11 +385,9 @@ var _ = Describe("Generate fluentd config", func() {
 			Secrets: map[string]*corev1.Secret{
 				"kafka-receiver": {
 					Data: map[string][]byte{
-						"username":               []byte("junk"),
-						"password":               []byte("junk"),
-						constants.SASLMechanisms: []byte("PLAIN"),
-						"sasl_over_ssl":          []byte("true"),
-						"passphrase":             []byte("-- passphrase --"),
+						"username":   []byte("junk"),
+						"password":   []byte("junk"),
+						"passphrase": []byte("-- passphrase --"),
 					},
 				},
 			},
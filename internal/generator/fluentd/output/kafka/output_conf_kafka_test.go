@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/openshift/cluster-logging-operator/internal/generator/fluentd/output/kafka"
+	"github.com/openshift/cluster-logging-operator/internal/migrations/clusterlogforwarder"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -162,18 +163,21 @@ var _ = Describe("Generating external kafka server output store config block", f
 		It("should enable Kafka if configured", func() {
 			kafkaConf = strings.Replace(kafkaConf, "sasl_over_ssl false", "sasl_over_ssl true", 1)
 			secret := &corev1.Secret{
-				Data: map[string][]byte{"sasl.enable": nil},
+				Data: map[string][]byte{},
 			}
-			results, err := g.GenerateConf(kafka.Conf(nil, secret, outputs[0], nil)...)
+			output := outputs[0]
+			output.Kafka = &v1.Kafka{SASL: &v1.KafkaSASL{}}
+			results, err := g.GenerateConf(kafka.Conf(nil, secret, output, nil)...)
 			Expect(err).To(BeNil())
 			Expect(results).To(EqualTrimLines(kafkaConf))
 		})
-		It("should recognize deprecated SASL key", func() {
+		It("should recognize deprecated SASL key once migrated", func() {
 			kafkaConf = strings.Replace(kafkaConf, "sasl_over_ssl false", "sasl_over_ssl true", 1)
 			secret := &corev1.Secret{
 				Data: map[string][]byte{"sasl_over_ssl": nil},
 			}
-			results, err := g.GenerateConf(kafka.Conf(nil, secret, outputs[0], nil)...)
+			spec, _, _ := clusterlogforwarder.MigrateKafkaSASL("", "", v1.ClusterLogForwarderSpec{Outputs: outputs}, nil, nil, "", "", map[string]*corev1.Secret{outputs[0].Name: secret})
+			results, err := g.GenerateConf(kafka.Conf(nil, secret, spec.Outputs[0], nil)...)
 			Expect(err).To(BeNil())
 			Expect(results).To(EqualTrimLines(kafkaConf))
 		})
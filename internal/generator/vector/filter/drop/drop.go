@@ -0,0 +1,57 @@
+// Package drop generates a VRL program that discards records matching any of a list of tests.
+package drop
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+// TestsToVRL returns a remap program that aborts, and so drops the record, if any test passes.
+// Errors describe the invalid test and are used as the validation message of the filter.
+func TestsToVRL(tests []loggingv1.DropTest) (string, error) {
+	if len(tests) == 0 {
+		return "", fmt.Errorf("drop filter must define at least one test")
+	}
+	ors := []string{}
+	for i, test := range tests {
+		if len(test.DropConditions) == 0 {
+			return "", fmt.Errorf("drop test %d must define at least one condition", i)
+		}
+		ands := []string{}
+		for _, cond := range test.DropConditions {
+			expr, err := conditionToVRL(cond)
+			if err != nil {
+				return "", fmt.Errorf("drop test %d: %w", i, err)
+			}
+			ands = append(ands, expr)
+		}
+		ors = append(ors, "("+strings.Join(ands, " && ")+")")
+	}
+	return fmt.Sprintf("if %s {\n  abort\n}", strings.Join(ors, " || ")), nil
+}
+
+func conditionToVRL(cond loggingv1.DropCondition) (string, error) {
+	if !helpers.IsFieldPath(cond.Field) {
+		return "", fmt.Errorf("invalid field path %q", cond.Field)
+	}
+	if (cond.Matches == "") == (cond.NotMatches == "") {
+		return "", fmt.Errorf("field %q must define exactly one of matches or notMatches", cond.Field)
+	}
+	re := cond.Matches + cond.NotMatches
+	if _, err := regexp.Compile(re); err != nil {
+		return "", fmt.Errorf("field %q has an invalid regular expression %q", cond.Field, re)
+	}
+	if cond.Matches != "" {
+		return fmt.Sprintf(`match(to_string(%s) ?? "", r'%s')`, cond.Field, escape(cond.Matches)), nil
+	}
+	return fmt.Sprintf(`!match(to_string(%s) ?? "", r'%s')`, cond.Field, escape(cond.NotMatches)), nil
+}
+
+// escape a regular expression for use in a VRL raw string literal.
+func escape(re string) string {
+	return strings.ReplaceAll(re, `'`, `\'`)
+}
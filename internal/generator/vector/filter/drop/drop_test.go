@@ -0,0 +1,63 @@
+package drop
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("#TestsToVRL", func() {
+
+	It("should AND conditions within a test and OR across tests", func() {
+		vrl, err := TestsToVRL([]loggingv1.DropTest{
+			{
+				DropConditions: []loggingv1.DropCondition{
+					{Field: ".kubernetes.namespace_name", Matches: "^open"},
+					{Field: ".level", NotMatches: "error|warning"},
+				},
+			},
+			{
+				DropConditions: []loggingv1.DropCondition{
+					{Field: `.kubernetes.labels."app.kubernetes.io/name"`, Matches: "health'check"},
+				},
+			},
+		})
+		Expect(err).To(BeNil())
+		Expect(vrl).To(EqualTrimLines(`
+if (match(to_string(.kubernetes.namespace_name) ?? "", r'^open') && !match(to_string(.level) ?? "", r'error|warning')) || (match(to_string(.kubernetes.labels."app.kubernetes.io/name") ?? "", r'health\'check')) {
+  abort
+}
+`))
+	})
+
+	It("should fail without tests", func() {
+		_, err := TestsToVRL(nil)
+		Expect(err).To(MatchError("drop filter must define at least one test"))
+	})
+
+	It("should fail for a test without conditions", func() {
+		_, err := TestsToVRL([]loggingv1.DropTest{{}})
+		Expect(err).To(MatchError("drop test 0 must define at least one condition"))
+	})
+
+	It("should fail for a condition with both matches and notMatches", func() {
+		_, err := TestsToVRL([]loggingv1.DropTest{{DropConditions: []loggingv1.DropCondition{{Field: ".level", Matches: "a", NotMatches: "b"}}}})
+		Expect(err).To(MatchError(`drop test 0: field ".level" must define exactly one of matches or notMatches`))
+	})
+
+	It("should fail for a condition with neither matches nor notMatches", func() {
+		_, err := TestsToVRL([]loggingv1.DropTest{{DropConditions: []loggingv1.DropCondition{{Field: ".level"}}}})
+		Expect(err).To(MatchError(`drop test 0: field ".level" must define exactly one of matches or notMatches`))
+	})
+
+	It("should fail for an invalid field path", func() {
+		_, err := TestsToVRL([]loggingv1.DropTest{{DropConditions: []loggingv1.DropCondition{{Field: "level", Matches: "a"}}}})
+		Expect(err).To(MatchError(`drop test 0: invalid field path "level"`))
+	})
+
+	It("should fail for an invalid regular expression", func() {
+		_, err := TestsToVRL([]loggingv1.DropTest{{DropConditions: []loggingv1.DropCondition{{Field: ".level", Matches: "debug("}}}})
+		Expect(err).To(MatchError(`drop test 0: field ".level" has an invalid regular expression "debug("`))
+	})
+})
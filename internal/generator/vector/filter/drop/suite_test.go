@@ -0,0 +1,13 @@
+package drop
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestDrop(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[filter][drop] Unit Tests")
+}
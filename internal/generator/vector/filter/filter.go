@@ -3,7 +3,10 @@ package filter
 import (
 	"fmt"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/drop"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/openshift"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/prune"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/remap"
 
 	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/apiaudit"
@@ -26,6 +29,12 @@ func VRLFrom(filterSpec *InternalFilterSpec) (vrl string, err error) {
 
 	case loggingv1.FilterKubeAPIAudit:
 		return apiaudit.PolicyToVRL(filterSpec.KubeAPIAudit)
+	case loggingv1.FilterDrop:
+		return drop.TestsToVRL(filterSpec.Drop)
+	case loggingv1.FilterPrune:
+		return prune.SpecToVRL(filterSpec.Prune)
+	case loggingv1.FilterRemap:
+		return remap.SpecToVRL(filterSpec.Remap)
 	case openshift.Labels:
 		return openshift.NewLabels(filterSpec.Labels)
 	case openshift.ParseJson:
@@ -20,4 +20,8 @@ var _ = Describe("filter validation", func() {
 		_, err := RemapVRL(&loggingv1.FilterSpec{Name: "foo", Type: loggingv1.FilterKubeAPIAudit})
 		Expect(err).To(Succeed())
 	})
+	It("rejects drop filter with no tests", func() {
+		_, err := RemapVRL(&loggingv1.FilterSpec{Name: "foo", Type: loggingv1.FilterDrop})
+		Expect(err).To(MatchError("filter foo: drop filter must define at least one test"))
+	})
 })
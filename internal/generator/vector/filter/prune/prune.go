@@ -0,0 +1,101 @@
+// Package prune generates a VRL program that removes fields from records.
+package prune
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/utils/sets"
+)
+
+// RequiredFields can not be removed by a prune filter.
+var RequiredFields = []string{".log_type", ".message"}
+
+var segmentRegex = regexp.MustCompile(`\.([a-zA-Z0-9_]+)|\."([^"]+)"`)
+
+// SpecToVRL returns a remap program that removes the fields in spec.In and all fields not in spec.NotIn.
+func SpecToVRL(spec *loggingv1.PruneFilterSpec) (string, error) {
+	if spec == nil || (len(spec.In) == 0 && len(spec.NotIn) == 0) {
+		return "", fmt.Errorf("prune filter must define at least one of in or notIn")
+	}
+	if err := checkPaths(spec); err != nil {
+		return "", err
+	}
+	if err := checkRequired(spec); err != nil {
+		return "", err
+	}
+	vrl := []string{}
+	if len(spec.NotIn) > 0 {
+		paths := [][]string{}
+		for _, f := range spec.NotIn {
+			paths = append(paths, Segments(f))
+		}
+		b, err := json.Marshal(paths)
+		if err != nil {
+			return "", err
+		}
+		vrl = append(vrl, fmt.Sprintf(`notIn = %s
+new_object = {}
+for_each(notIn) -> |_index, path| {
+  val = get(., path) ?? null
+  if !is_null(val) {
+    new_object = set(new_object, path, val) ?? new_object
+  }
+}
+. = new_object`, b))
+	}
+	for _, f := range spec.In {
+		vrl = append(vrl, fmt.Sprintf("del(%s)", f))
+	}
+	return strings.Join(vrl, "\n"), nil
+}
+
+func checkPaths(spec *loggingv1.PruneFilterSpec) error {
+	for _, f := range append(append([]string{}, spec.In...), spec.NotIn...) {
+		if !helpers.IsFieldPath(f) {
+			return fmt.Errorf("prune filter: invalid field path %q", f)
+		}
+	}
+	return nil
+}
+
+// checkRequired compares the normalized paths, so the quoted form of a required field, e.g. ."message", is recognized
+func checkRequired(spec *loggingv1.PruneFilterSpec) error {
+	in, notIn := normalize(spec.In), normalize(spec.NotIn)
+	for _, required := range RequiredFields {
+		path := strings.Join(Segments(required), ".")
+		if in.Has(path) {
+			return fmt.Errorf("prune filter: in can not include required field %q", required)
+		}
+		if notIn.Len() > 0 && !notIn.Has(path) {
+			return fmt.Errorf("prune filter: notIn must include required field %q", required)
+		}
+	}
+	return nil
+}
+
+// normalize returns the set of paths with unquoted segments
+func normalize(paths []string) *sets.String {
+	normalized := sets.NewString()
+	for _, path := range paths {
+		normalized.Insert(strings.Join(Segments(path), "."))
+	}
+	return normalized
+}
+
+// Segments splits a dot delimited field path into its unquoted segments.
+func Segments(path string) []string {
+	segments := []string{}
+	for _, m := range segmentRegex.FindAllStringSubmatch(path, -1) {
+		if m[1] != "" {
+			segments = append(segments, m[1])
+		} else {
+			segments = append(segments, m[2])
+		}
+	}
+	return segments
+}
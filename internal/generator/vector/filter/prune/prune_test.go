@@ -0,0 +1,72 @@
+package prune
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("#SpecToVRL", func() {
+
+	It("should delete fields listed in 'in'", func() {
+		vrl, err := SpecToVRL(&loggingv1.PruneFilterSpec{
+			In: []string{".kubernetes.annotations", `.kubernetes.labels."app.kubernetes.io/name"`},
+		})
+		Expect(err).To(BeNil())
+		Expect(vrl).To(EqualTrimLines(`
+del(.kubernetes.annotations)
+del(.kubernetes.labels."app.kubernetes.io/name")
+`))
+	})
+
+	It("should keep only fields listed in 'notIn' before deleting fields in 'in'", func() {
+		vrl, err := SpecToVRL(&loggingv1.PruneFilterSpec{
+			NotIn: []string{".log_type", ".message", ".kubernetes", `.openshift.labels."my-label"`},
+			In:    []string{".kubernetes.annotations"},
+		})
+		Expect(err).To(BeNil())
+		Expect(vrl).To(EqualTrimLines(`
+notIn = [["log_type"],["message"],["kubernetes"],["openshift","labels","my-label"]]
+new_object = {}
+for_each(notIn) -> |_index, path| {
+  val = get(., path) ?? null
+  if !is_null(val) {
+    new_object = set(new_object, path, val) ?? new_object
+  }
+}
+. = new_object
+del(.kubernetes.annotations)
+`))
+	})
+
+	It("should fail without fields", func() {
+		_, err := SpecToVRL(&loggingv1.PruneFilterSpec{})
+		Expect(err).To(MatchError("prune filter must define at least one of in or notIn"))
+	})
+
+	It("should refuse to remove a required field", func() {
+		_, err := SpecToVRL(&loggingv1.PruneFilterSpec{In: []string{".kubernetes.labels", ".message"}})
+		Expect(err).To(MatchError(`prune filter: in can not include required field ".message"`))
+	})
+
+	It("should refuse notIn without a required field", func() {
+		_, err := SpecToVRL(&loggingv1.PruneFilterSpec{NotIn: []string{".message"}})
+		Expect(err).To(MatchError(`prune filter: notIn must include required field ".log_type"`))
+	})
+
+	It("should refuse to remove the quoted form of a required field", func() {
+		_, err := SpecToVRL(&loggingv1.PruneFilterSpec{In: []string{`."message"`}})
+		Expect(err).To(MatchError(`prune filter: in can not include required field ".message"`))
+	})
+
+	It("should accept the quoted form of a required field in notIn", func() {
+		_, err := SpecToVRL(&loggingv1.PruneFilterSpec{NotIn: []string{`."log_type"`, `."message"`}})
+		Expect(err).To(BeNil())
+	})
+
+	It("should fail for an invalid field path", func() {
+		_, err := SpecToVRL(&loggingv1.PruneFilterSpec{In: []string{"kubernetes.labels"}})
+		Expect(err).To(MatchError(`prune filter: invalid field path "kubernetes.labels"`))
+	})
+})
@@ -0,0 +1,13 @@
+package prune
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestPrune(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[filter][prune] Unit Tests")
+}
@@ -0,0 +1,117 @@
+// Package remap checks and generates user supplied VRL remap programs.
+package remap
+
+import (
+	"fmt"
+	"strings"
+
+	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils/sets"
+)
+
+// MaxSourceBytes is the largest program accepted by a remap filter.
+const MaxSourceBytes = 16 * 1024
+
+// ForbiddenFunctions expose the collector environment or secrets to the program.
+var ForbiddenFunctions = sets.NewString("get_env_var", "get_secret", "set_secret", "remove_secret")
+
+var brackets = map[rune]rune{')': '(', ']': '[', '}': '{'}
+
+// SpecToVRL returns the program in spec if it passes Check.
+func SpecToVRL(spec *loggingv1.RemapFilterSpec) (string, error) {
+	if spec == nil || strings.TrimSpace(spec.Source) == "" {
+		return "", fmt.Errorf("remap filter requires a source program")
+	}
+	if err := Check(spec.Source); err != nil {
+		return "", err
+	}
+	return spec.Source, nil
+}
+
+// Check statically verifies a VRL program before it is deployed to the collector.
+// It does not compile the program, it rejects programs that are obviously broken or unsafe:
+// unbalanced brackets or string literals, forbidden function calls and oversized programs.
+func Check(source string) error {
+	if len(source) > MaxSourceBytes {
+		return fmt.Errorf("remap source is %d bytes, the limit is %d", len(source), MaxSourceBytes)
+	}
+	// The program is embedded in a TOML multi-line literal string.
+	if strings.Contains(source, "'''") {
+		return fmt.Errorf("remap source must not contain '''")
+	}
+	runes := []rune(source)
+	stack := []rune{}
+	line := 1
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch {
+		case c == '\n':
+			line++
+		case c == '#':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+			line++
+		case c == '"' || c == '\'':
+			start := line
+			end := closeQuote(runes, i, c)
+			if end < 0 {
+				return fmt.Errorf("remap source line %d: unterminated string literal", start)
+			}
+			line += strings.Count(string(runes[i:end]), "\n")
+			i = end
+		case c == '(' || c == '[' || c == '{':
+			stack = append(stack, c)
+		case c == ')' || c == ']' || c == '}':
+			if len(stack) == 0 || stack[len(stack)-1] != brackets[c] {
+				return fmt.Errorf("remap source line %d: unbalanced %q", line, c)
+			}
+			stack = stack[:len(stack)-1]
+		case isIdentStart(c) && (i == 0 || !isIdent(runes[i-1])):
+			j := i
+			for j < len(runes) && isIdent(runes[j]) {
+				j++
+			}
+			if name := string(runes[i:j]); ForbiddenFunctions.Has(name) && isCall(runes, j) {
+				return fmt.Errorf("remap source line %d: function %s is not allowed", line, name)
+			}
+			i = j - 1
+		}
+	}
+	if len(stack) > 0 {
+		return fmt.Errorf("remap source: unclosed %q", stack[len(stack)-1])
+	}
+	return nil
+}
+
+// closeQuote returns the index of the quote closing the literal starting at runes[start], or -1.
+func closeQuote(runes []rune, start int, quote rune) int {
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			i++
+		case quote:
+			return i
+		}
+	}
+	return -1
+}
+
+// isCall is true if the identifier ending before runes[i] is followed by an optional '!' and '('.
+func isCall(runes []rune, i int) bool {
+	for i < len(runes) && (runes[i] == ' ' || runes[i] == '\t') {
+		i++
+	}
+	if i < len(runes) && runes[i] == '!' {
+		i++
+	}
+	return i < len(runes) && runes[i] == '('
+}
+
+func isIdentStart(c rune) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdent(c rune) bool {
+	return isIdentStart(c) || (c >= '0' && c <= '9')
+}
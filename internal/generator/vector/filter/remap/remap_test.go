@@ -0,0 +1,57 @@
+package remap
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+)
+
+var _ = Describe("#SpecToVRL", func() {
+
+	It("should return the source unchanged", func() {
+		source := `.level = downcase(string!(.level)) ?? "unknown"`
+		vrl, err := SpecToVRL(&loggingv1.RemapFilterSpec{Source: source})
+		Expect(err).To(BeNil())
+		Expect(vrl).To(Equal(source))
+	})
+
+	It("should fail without a source", func() {
+		_, err := SpecToVRL(&loggingv1.RemapFilterSpec{Source: "  "})
+		Expect(err).To(MatchError("remap filter requires a source program"))
+		_, err = SpecToVRL(nil)
+		Expect(err).To(MatchError("remap filter requires a source program"))
+	})
+})
+
+var _ = DescribeTable("#Check",
+	func(source, message string) {
+		err := Check(source)
+		if message == "" {
+			Expect(err).To(BeNil())
+		} else {
+			Expect(err).To(MatchError(message))
+		}
+	},
+	Entry("should pass a valid program", `
+if exists(.kubernetes.labels."app.kubernetes.io/name") {
+  .app = .kubernetes.labels."app.kubernetes.io/name"
+}
+.tags = ["a", "b"]
+`, ""),
+	Entry("should ignore brackets in strings and comments", `
+# closing ) in a comment
+.message = "unbalanced ( in \" a string"
+.match = match(.message, r'^\s*\[') ?? false
+`, ""),
+	Entry("should ignore forbidden function names in strings", `.note = "get_env_var(HOME)"`, ""),
+	Entry("should fail with an unclosed brace", "if true {\n  .a = 1\n", `remap source: unclosed '{'`),
+	Entry("should fail with a mismatched bracket", ".a = [1, 2)\n", `remap source line 1: unbalanced ')'`),
+	Entry("should fail with an unterminated string", ".a = 1\n.b = \"abc\n", "remap source line 2: unterminated string literal"),
+	Entry("should fail calling get_env_var", ".a = 1\n.home = get_env_var!(\"HOME\")", "remap source line 2: function get_env_var is not allowed"),
+	Entry("should fail calling get_secret", `.token = get_secret("token")`, "remap source line 1: function get_secret is not allowed"),
+	Entry("should fail with a TOML literal delimiter", ".a = s'''", "remap source must not contain '''"),
+	Entry("should fail when too large", strings.Repeat("#", MaxSourceBytes+1), "remap source is 16385 bytes, the limit is 16384"),
+)
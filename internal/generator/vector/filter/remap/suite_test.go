@@ -0,0 +1,13 @@
+package remap
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRemap(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[filter][remap] Unit Tests")
+}
@@ -0,0 +1,177 @@
+package helpers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/openshift/cluster-logging-operator/internal/utils/sets"
+)
+
+const (
+	// DefaultTemplateFallback replaces a template field that is missing or is not a string when no fallback is given
+	DefaultTemplateFallback = "unknown"
+
+	// tomlLiteralDelimiter ends the TOML multi-line literal strings that hold the generated VRL programs
+	tomlLiteralDelimiter = "'''"
+)
+
+var (
+	// templateFieldRegex matches a template field reference, e.g. {.kubernetes.namespace_name} or {.log_type||"none"}
+	templateFieldRegex = regexp.MustCompile(`\{([^{}|]*)(?:\|\|"([^"{}]*)")?\}`)
+
+	// templateFieldPathRegex matches a dot delimited record path where each segment is either
+	// plain (a-zA-Z0-9_) or quoted without backslashes, e.g. .kubernetes.labels."app.kubernetes.io/name"
+	templateFieldPathRegex = regexp.MustCompile(`^(\.[a-zA-Z0-9_]+|\."[^"\\]+")+$`)
+
+	// templateRootFields are the top level fields of the ViaQ data model that may be referenced by a template
+	templateRootFields = sets.NewString(
+		"@timestamp", "docker", "hostname", "kubernetes", "level", "log_type", "message", "openshift",
+		"pipeline_metadata", "structured", "systemd", "viaq_index_name", "viaq_msg_id",
+		"audit.linux", "auditID", "k8s_audit_level", "objectRef", "openshift_audit_level", "requestURI",
+		"responseStatus", "stage", "user", "verb",
+	)
+)
+
+// TemplatePart is either literal text or a record field reference of an output template
+type TemplatePart struct {
+	Literal  string
+	Field    string
+	Fallback string
+	// HasFallback is true when the fallback value was given explicitly in the template
+	HasFallback bool
+}
+
+// ParseTemplate splits an output template into literal text and record field references.
+// Fields are written as {.path.to.field} with an optional fallback value {.path.to.field||"fallback"}
+func ParseTemplate(template string) ([]TemplatePart, error) {
+	if strings.Contains(template, tomlLiteralDelimiter) {
+		return nil, fmt.Errorf("template %q must not contain %s", template, tomlLiteralDelimiter)
+	}
+	parts := []TemplatePart{}
+	addLiteral := func(literal string) error {
+		if strings.ContainsAny(literal, "{}") {
+			return fmt.Errorf("template %q has unbalanced braces", template)
+		}
+		if literal != "" {
+			parts = append(parts, TemplatePart{Literal: literal})
+		}
+		return nil
+	}
+	last := 0
+	for _, m := range templateFieldRegex.FindAllStringSubmatchIndex(template, -1) {
+		if err := addLiteral(template[last:m[0]]); err != nil {
+			return nil, err
+		}
+		field := template[m[2]:m[3]]
+		if !IsFieldPath(field) {
+			return nil, fmt.Errorf("template %q has an invalid field path %q", template, field)
+		}
+		part := TemplatePart{Field: field, Fallback: DefaultTemplateFallback}
+		if m[4] >= 0 {
+			part.Fallback = template[m[4]:m[5]]
+			part.HasFallback = true
+		}
+		parts = append(parts, part)
+		last = m[1]
+	}
+	if err := addLiteral(template[last:]); err != nil {
+		return nil, err
+	}
+	return parts, nil
+}
+
+// IsTemplate returns true if the value references at least one record field
+func IsTemplate(value string) bool {
+	return templateFieldRegex.MatchString(value)
+}
+
+// VerifyTemplate parses a template and additionally requires that every field is rooted at a known
+// ViaQ data model field and has an explicit fallback value
+func VerifyTemplate(template string) error {
+	parts, err := ParseTemplate(template)
+	if err != nil {
+		return err
+	}
+	for _, p := range parts {
+		if p.Field == "" {
+			continue
+		}
+		if !isKnownField(p.Field) {
+			return fmt.Errorf("template %q references unknown field %q", template, p.Field)
+		}
+		if !p.HasFallback {
+			return fmt.Errorf("template %q requires a fallback value for field %q", template, p.Field)
+		}
+	}
+	return nil
+}
+
+// VerifyFieldPath verifies a record field path is valid and rooted at a known ViaQ data model field
+func VerifyFieldPath(path string) error {
+	if !IsFieldPath(path) {
+		return fmt.Errorf("invalid field path %q", path)
+	}
+	if !isKnownField(path) {
+		return fmt.Errorf("unknown field %q", path)
+	}
+	return nil
+}
+
+// IsFieldPath returns true if the path is a valid record field path, e.g. .kubernetes.labels."app.kubernetes.io/name",
+// that can be inserted as is into a generated VRL program
+func IsFieldPath(path string) bool {
+	return templateFieldPathRegex.MatchString(path) && !strings.Contains(path, tomlLiteralDelimiter)
+}
+
+func isKnownField(path string) bool {
+	return templateRootFields.Has(templateFieldRoot(path))
+}
+
+// templateFieldRoot returns the first segment of a field path, unquoted
+func templateFieldRoot(field string) string {
+	path := strings.TrimPrefix(field, ".")
+	if strings.HasPrefix(path, `"`) {
+		if end := strings.Index(path[1:], `"`); end >= 0 {
+			return path[1 : end+1]
+		}
+	}
+	if end := strings.Index(path, "."); end >= 0 {
+		return path[:end]
+	}
+	return path
+}
+
+// TemplateToVRL returns a VRL string expression rendering a template that was verified by ParseTemplate
+func TemplateToVRL(template string) string {
+	return templateToVRL(template, func(literal string) string {
+		return fmt.Sprintf("%q", literal)
+	})
+}
+
+// TimeTemplateToVRL returns a VRL string expression rendering a template that was verified by ParseTemplate.
+// strftime specifiers (e.g. %Y-%m-%d) in the literal text are rendered using the timestamp variable tsVar
+func TimeTemplateToVRL(template, tsVar string) string {
+	return templateToVRL(template, func(literal string) string {
+		if strings.Contains(literal, "%") {
+			return fmt.Sprintf("format_timestamp!(%s, format: %q)", tsVar, literal)
+		}
+		return fmt.Sprintf("%q", literal)
+	})
+}
+
+func templateToVRL(template string, literalToVRL func(string) string) string {
+	parts, err := ParseTemplate(template)
+	if err != nil || len(parts) == 0 {
+		return `""`
+	}
+	exprs := make([]string, len(parts))
+	for i, p := range parts {
+		if p.Field != "" {
+			exprs[i] = fmt.Sprintf("(string(%s) ?? %q)", p.Field, p.Fallback)
+		} else {
+			exprs[i] = literalToVRL(p.Literal)
+		}
+	}
+	return strings.Join(exprs, " + ")
+}
@@ -0,0 +1,65 @@
+package helpers
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("#ParseTemplate", func() {
+
+	DescribeTable("should render valid templates as VRL", func(template, exp string) {
+		_, err := ParseTemplate(template)
+		Expect(err).To(BeNil())
+		Expect(TemplateToVRL(template)).To(Equal(exp))
+	},
+		Entry("with only literal text", "app-logs", `"app-logs"`),
+		Entry("with a field", "{.log_type}", `(string(.log_type) ?? "unknown")`),
+		Entry("with a field and fallback", `logs-{.kubernetes.namespace_name||"none"}`, `"logs-" + (string(.kubernetes.namespace_name) ?? "none")`),
+		Entry("with a quoted field segment", `{.kubernetes.labels."app.kubernetes.io/name"}/`, `(string(.kubernetes.labels."app.kubernetes.io/name") ?? "unknown") + "/"`),
+	)
+
+	DescribeTable("should fail invalid templates", func(template, exp string) {
+		_, err := ParseTemplate(template)
+		Expect(err).To(MatchError(exp))
+	},
+		Entry("with an unclosed brace", "{.log_type", `template "{.log_type" has unbalanced braces`),
+		Entry("with a stray closing brace", "logs}", `template "logs}" has unbalanced braces`),
+		Entry("with a field that is not a path", "{log_type}", `template "{log_type}" has an invalid field path "log_type"`),
+		Entry("with the delimiter of the VRL program", `logs-'''{.log_type||"none"}`, `template "logs-'''{.log_type||\"none\"}" must not contain '''`),
+	)
+
+	DescribeTable("should verify fields of templates", func(template, exp string) {
+		err := VerifyTemplate(template)
+		if exp == "" {
+			Expect(err).To(BeNil())
+		} else {
+			Expect(err).To(MatchError(exp))
+		}
+	},
+		Entry("with only literal text", "app-logs", ""),
+		Entry("with known fields and fallbacks", `app-{.kubernetes.namespace_name||"none"}-{."@timestamp"||"now"}`, ""),
+		Entry("with an unknown field", `app-{.namespace||"none"}`, `template "app-{.namespace||\"none\"}" references unknown field ".namespace"`),
+		Entry("with a missing fallback", "app-{.kubernetes.namespace_name}", `template "app-{.kubernetes.namespace_name}" requires a fallback value for field ".kubernetes.namespace_name"`),
+	)
+
+	DescribeTable("should verify field paths", func(path, exp string) {
+		err := VerifyFieldPath(path)
+		if exp == "" {
+			Expect(err).To(BeNil())
+		} else {
+			Expect(err).To(MatchError(exp))
+		}
+	},
+		Entry("with a known field", ".viaq_msg_id", ""),
+		Entry("with a quoted segment", `.kubernetes.labels."app.kubernetes.io/name"`, ""),
+		Entry("with an invalid path", "viaq_msg_id", `invalid field path "viaq_msg_id"`),
+		Entry("with a backslash in a quoted segment", `.kubernetes.labels."app\"`, `invalid field path ".kubernetes.labels.\"app\\\""`),
+		Entry("with the delimiter of the VRL program in a quoted segment", `.kubernetes.labels."a'''b"`, `invalid field path ".kubernetes.labels.\"a'''b\""`),
+		Entry("with an unknown field", ".msg_id", `unknown field ".msg_id"`),
+	)
+
+	It("should render strftime specifiers of literal text from a timestamp", func() {
+		Expect(TimeTemplateToVRL("{.log_type}/%Y/%m/%d/", "ts")).To(Equal(`(string(.log_type) ?? "unknown") + format_timestamp!(ts, format: "/%Y/%m/%d/")`))
+	})
+})
@@ -24,6 +24,15 @@ func NewViaqReceiverSource(spec logging.InputSpec, resNames *factory.ForwarderRe
 		el = []generator.Element{source.NewHttpSource(base, resNames.GenerateInputServiceName(spec.Name), spec, op)}
 		id = helpers.MakeID(base, "viaq")
 		el = append(el, vector.NormalizeK8sAuditLogs(helpers.MakeID(base, "items"), id)...)
+	case logging.IsHttpReceiver(&spec):
+		src := source.NewHttpSource(base, resNames.GenerateInputServiceName(spec.Name), spec, op)
+		el = []generator.Element{src}
+		id = helpers.MakeID(base, "viaq")
+		mapping := spec.Receiver.HTTP.FieldMapping
+		if mapping == nil {
+			mapping = &logging.HTTPReceiverFieldMapping{}
+		}
+		el = append(el, vector.NormalizeHttpReceiverLogs(src.OutputID(), id, mapping.Message, mapping.Timestamp, mapping.Level)...)
 	}
 	return el, []string{id}
 }
@@ -126,10 +126,12 @@ func addLogType(spec logging.InputSpec, els []framework.Element, ids []string) (
 	switch {
 	case spec.Application != nil:
 		logType = logging.InputNameApplication
-	case spec.Infrastructure != nil || logging.IsSyslogReceiver(&spec):
+	case spec.Infrastructure != nil:
 		logType = logging.InputNameInfrastructure
-	case spec.Audit != nil || logging.IsAuditHttpReceiver(&spec):
+	case spec.Audit != nil:
 		logType = logging.InputNameAudit
+	case spec.Receiver != nil:
+		logType = logging.ReceiverLogType(&spec)
 	}
 
 	if logType != "" {
@@ -141,7 +143,7 @@ func addLogType(spec logging.InputSpec, els []framework.Element, ids []string) (
 			Inputs:      helpers.MakeInputs(ids...),
 			VRL:         fmt.Sprintf(".log_type = %q", logType),
 		}
-		if logType == logging.InputNameAudit {
+		if spec.Audit != nil || logging.IsAuditHttpReceiver(&spec) {
 			remap.VRL = strings.Join(helpers.TrimSpaces([]string{
 				remap.VRL,
 				normalize.FixHostname,
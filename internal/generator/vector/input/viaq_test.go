@@ -166,6 +166,77 @@ var _ = Describe("inputs", func() {
 		},
 			"viaq_receiver_http_audit.toml",
 		),
+		Entry("with an ndjson http receiver input should generate VIAQ http receiver source with the field mapping", logging.InputSpec{
+			Name: "myreceiver",
+			Receiver: &logging.ReceiverSpec{
+				Type: logging.ReceiverTypeHttp,
+				ReceiverTypeSpec: &logging.ReceiverTypeSpec{
+					HTTP: &logging.HTTPReceiver{
+						Port:    12345,
+						Format:  logging.FormatNDJSON,
+						LogType: "serverless",
+						FieldMapping: &logging.HTTPReceiverFieldMapping{
+							Message:   ".msg",
+							Timestamp: ".ts",
+							Level:     ".severity",
+						},
+					},
+				},
+			},
+		},
+			"viaq_receiver_http_ndjson.toml",
+		),
+		Entry("with a text http receiver input should generate VIAQ http receiver source of application logs", logging.InputSpec{
+			Name: "myreceiver",
+			Receiver: &logging.ReceiverSpec{
+				Type: logging.ReceiverTypeHttp,
+				ReceiverTypeSpec: &logging.ReceiverTypeSpec{
+					HTTP: &logging.HTTPReceiver{
+						Port:   12345,
+						Format: logging.FormatText,
+					},
+				},
+			},
+		},
+			"viaq_receiver_http_text.toml",
+		),
+		Entry("with an http audit receiver input with mutual TLS and basic auth should generate VIAQ http receiver audit source with authentication", logging.InputSpec{
+			Name: "myreceiver",
+			Receiver: &logging.ReceiverSpec{
+				Type: logging.ReceiverTypeHttp,
+				ReceiverTypeSpec: &logging.ReceiverTypeSpec{
+					HTTP: &logging.HTTPReceiver{
+						Port:     12345,
+						Format:   logging.FormatKubeAPIAudit,
+						ClientCA: &logging.ValueReference{Key: "ca-bundle.crt", ConfigMapName: "audit-ca"},
+						Authentication: &logging.HTTPReceiverAuthentication{
+							Type:       logging.HTTPReceiverAuthBasic,
+							SecretName: "audit-webhook",
+						},
+					},
+				},
+			},
+		},
+			"viaq_receiver_http_audit_basic_auth.toml",
+		),
+		Entry("with an http receiver input with bearer token auth should generate VIAQ http receiver source dropping unauthenticated records", logging.InputSpec{
+			Name: "myreceiver",
+			Receiver: &logging.ReceiverSpec{
+				Type: logging.ReceiverTypeHttp,
+				ReceiverTypeSpec: &logging.ReceiverTypeSpec{
+					HTTP: &logging.HTTPReceiver{
+						Port:   12345,
+						Format: logging.FormatText,
+						Authentication: &logging.HTTPReceiverAuthentication{
+							Type:       logging.HTTPReceiverAuthBearerToken,
+							SecretName: "http-token",
+						},
+					},
+				},
+			},
+		},
+			"viaq_receiver_http_bearer_token.toml",
+		),
 		Entry("with a syslog receiver input should generate VIAQ syslog receiver", logging.InputSpec{
 			Name: "myreceiver",
 			Receiver: &logging.ReceiverSpec{
@@ -179,5 +250,36 @@ var _ = Describe("inputs", func() {
 		},
 			"viaq_receiver_syslog.toml",
 		),
+		Entry("with a udp syslog receiver input should generate VIAQ syslog receiver without TLS", logging.InputSpec{
+			Name: "myreceiver",
+			Receiver: &logging.ReceiverSpec{
+				Type: logging.ReceiverTypeSyslog,
+				ReceiverTypeSpec: &logging.ReceiverTypeSpec{
+					Syslog: &logging.SyslogReceiver{
+						Port:     12345,
+						Protocol: logging.SyslogReceiverProtocolUDP,
+						LogType:  logging.InputNameApplication,
+					},
+				},
+			},
+		},
+			"viaq_receiver_syslog_udp.toml",
+		),
+		Entry("with a syslog receiver input with a client CA should generate VIAQ syslog receiver with mutual TLS", logging.InputSpec{
+			Name: "myreceiver",
+			Receiver: &logging.ReceiverSpec{
+				Type: logging.ReceiverTypeSyslog,
+				ReceiverTypeSpec: &logging.ReceiverTypeSpec{
+					Syslog: &logging.SyslogReceiver{
+						Port:     12345,
+						Protocol: logging.SyslogReceiverProtocolTLS,
+						ClientCA: &logging.ValueReference{Key: "ca-bundle.crt", ConfigMapName: "syslog-ca"},
+						LogType:  "appliances",
+					},
+				},
+			},
+		},
+			"viaq_receiver_syslog_mtls.toml",
+		),
 	)
 })
@@ -0,0 +1,55 @@
+package normalize
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	// DefaultLevelWithoutMessage skips the detection of the level from the message of records without one
+	DefaultLevelWithoutMessage = `if !exists(.level) && !is_string(.message) { .level = "default" }`
+	RemovePath                 = `del(.path)`
+)
+
+// NormalizeHttpReceiverLogs normalizes the records of a http receiver, moving the fields of the mapping to the
+// message, timestamp and level of the record
+func NormalizeHttpReceiverLogs(inputs, id, message, timestamp, level string) []framework.Element {
+	vrl := []string{ClusterID}
+	for _, m := range []struct{ path, target string }{
+		{message, ".message"},
+		{level, ".level"},
+	} {
+		if m.path != "" && m.path != m.target {
+			vrl = append(vrl, fmt.Sprintf("if exists(%s) { %s = del(%s) }", m.path, m.target, m.path))
+		}
+	}
+	if timestamp != "" {
+		// The timestamp is parsed, fields which are not an RFC3339 timestamp are kept in place
+		vrl = append(vrl, fmt.Sprintf(`if exists(%s) {
+  ts, err = parse_timestamp(string(%s) ?? "", "%%+")
+  if err == null {
+    del(%s)
+    ."@timestamp" = ts
+  }
+}`, timestamp, timestamp, timestamp))
+	}
+	vrl = append(vrl,
+		DefaultLevelWithoutMessage,
+		FixLogLevel,
+		RemoveSourceType,
+		RemovePath,
+		FixHostname,
+		FixTimestampField,
+	)
+	return []framework.Element{
+		Remap{
+			ComponentID: id,
+			Inputs:      helpers.MakeInputs(inputs),
+			VRL:         strings.Join(helpers.TrimSpaces(vrl), "\n"),
+		},
+	}
+}
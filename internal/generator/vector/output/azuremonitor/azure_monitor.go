@@ -0,0 +1,80 @@
+package azuremonitor
+
+import (
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	genhelper "github.com/openshift/cluster-logging-operator/internal/generator/helpers"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/normalize"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type AzureMonitor struct {
+	ComponentID string
+	Inputs      string
+	CustomerId  string
+	LogType     string
+	Host        string
+	SharedKey   common.SharedKey
+}
+
+func (a AzureMonitor) Name() string {
+	return "azureMonitorTemplate"
+}
+
+func (a AzureMonitor) Template() string {
+	return `{{define "` + a.Name() + `" -}}
+[sinks.{{.ComponentID}}]
+type = "azure_monitor_logs"
+inputs = {{.Inputs}}
+customer_id = "{{.CustomerId}}"
+log_type = "{{.LogType}}"
+shared_key = "{{.SharedKey.Key}}"
+{{- if .Host }}
+host = "{{.Host}}"
+{{- end }}
+{{end}}`
+}
+
+func New(id string, o logging.OutputSpec, inputs []string, secret *corev1.Secret, op Options) []Element {
+	if genhelper.IsDebugOutput(op) {
+		return []Element{
+			Debug(id, vectorhelpers.MakeInputs(inputs...)),
+		}
+	}
+	dedottedID := vectorhelpers.MakeID(id, "dedot")
+	return MergeElements(
+		[]Element{
+			normalize.DedotLabels(dedottedID, inputs),
+			Output(id, o, []string{dedottedID}, secret),
+			common.NewBuffer(id, o),
+			common.NewRequest(id, o),
+		},
+		common.NewBatch(id, o),
+		TLSConf(id, o, secret, op),
+	)
+}
+
+func Output(id string, o logging.OutputSpec, inputs []string, secret *corev1.Secret) Element {
+	return AzureMonitor{
+		ComponentID: id,
+		Inputs:      vectorhelpers.MakeInputs(inputs...),
+		CustomerId:  o.AzureMonitor.CustomerId,
+		LogType:     o.AzureMonitor.LogType,
+		Host:        o.AzureMonitor.Host,
+		SharedKey: common.SharedKey{
+			Key: common.GetFromSecret(secret, constants.SharedKey),
+		},
+	}
+}
+
+func TLSConf(id string, o logging.OutputSpec, secret *corev1.Secret, op Options) []Element {
+	if tlsConf := common.GenerateTLSConfWithID(id, o, secret, op, false); tlsConf != nil {
+		tlsConf.NeedsEnabled = false
+		return []Element{tlsConf}
+	}
+	return []Element{}
+}
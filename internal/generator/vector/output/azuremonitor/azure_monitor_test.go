@@ -0,0 +1,83 @@
+package azuremonitor
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/normalize"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Generate vector config for Azure Monitor output", func() {
+
+	var (
+		output logging.OutputSpec
+		secret = &corev1.Secret{
+			Data: map[string][]byte{
+				"shared_key": []byte("z9uEBqblq5jAhPbHZ8nq8A=="),
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		output = logging.OutputSpec{
+			Type: logging.OutputTypeAzureMonitor,
+			Name: "azure",
+			Secret: &logging.OutputSecretSpec{
+				Name: "azure-secret",
+			},
+			OutputTypeSpec: logging.OutputTypeSpec{
+				AzureMonitor: &logging.AzureMonitor{
+					CustomerId: "6a8e2b1c-0f3d-4e5a-9b7c-1d2e3f4a5b6c",
+					LogType:    "openshift_logs",
+				},
+			},
+		}
+	})
+
+	It("should send records to the workspace with the shared key", func() {
+		conf := New("azure", output, []string{"pipeline_a"}, secret, framework.NoOptions)
+		Expect(conf[0]).To(Equal(normalize.DedotLabels("azure_dedot", []string{"pipeline_a"})))
+		Expect(`
+[sinks.azure]
+type = "azure_monitor_logs"
+inputs = ["azure_dedot"]
+customer_id = "6a8e2b1c-0f3d-4e5a-9b7c-1d2e3f4a5b6c"
+log_type = "openshift_logs"
+shared_key = "z9uEBqblq5jAhPbHZ8nq8A=="
+
+[sinks.azure.buffer]
+when_full = "drop_newest"
+
+[sinks.azure.request]
+retry_attempts = 17
+`).To(EqualConfigFrom(conf[1:]))
+	})
+
+	It("should override the host and add TLS settings", func() {
+		output.AzureMonitor.Host = "ods.opinsights.azure.us"
+		output.TLS = &logging.OutputTLSSpec{InsecureSkipVerify: true}
+		conf := New("azure", output, []string{"pipeline_a"}, secret, framework.NoOptions)
+		Expect(`
+[sinks.azure]
+type = "azure_monitor_logs"
+inputs = ["azure_dedot"]
+customer_id = "6a8e2b1c-0f3d-4e5a-9b7c-1d2e3f4a5b6c"
+log_type = "openshift_logs"
+shared_key = "z9uEBqblq5jAhPbHZ8nq8A=="
+host = "ods.opinsights.azure.us"
+
+[sinks.azure.buffer]
+when_full = "drop_newest"
+
+[sinks.azure.request]
+retry_attempts = 17
+
+[sinks.azure.tls]
+verify_certificate = false
+verify_hostname = false
+`).To(EqualConfigFrom(conf[1:]))
+	})
+})
@@ -0,0 +1,13 @@
+package azuremonitor
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAzureMonitor(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[generator][vector][output][azuremonitor] Unit Tests")
+}
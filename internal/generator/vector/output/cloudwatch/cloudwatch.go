@@ -38,6 +38,7 @@ type CloudWatch struct {
 	ComponentID    string
 	Inputs         string
 	Region         string
+	Compression    string
 	EndpointConfig Element
 	SecurityConfig Element
 }
@@ -56,7 +57,7 @@ func (e CloudWatch) Template() string {
 type = "aws_cloudwatch_logs"
 inputs = {{.Inputs}}
 region = "{{.Region}}"
-compression = "none"
+compression = "{{.Compression}}"
 group_name = "{{"{{ group_name }}"}}"
 stream_name = "{{"{{ stream_name }}"}}"
 {{compose_one .SecurityConfig}}
@@ -76,16 +77,19 @@ func New(id string, o logging.OutputSpec, inputs []string, secret *corev1.Secret
 			Debug(id, helpers.MakeInputs([]string{componentID}...)),
 		}
 	}
-	request := common.NewRequest(id)
-	request.Concurrency.Value = 2
+	request := common.NewRequest(id, o)
+	if request.Concurrency.Value == nil {
+		request.Concurrency.Value = 2
+	}
 	return MergeElements(
 		[]Element{
 			NormalizeGroupAndStreamName(LogGroupNameField(o), LogGroupPrefix(o), componentID, inputs),
 			normalize.DedotLabels(dedottedID, []string{componentID}),
 			OutputConf(id, o, []string{dedottedID}, secret, op, o.Cloudwatch.Region),
-			common.NewBuffer(id),
+			common.NewBuffer(id, o),
 			request,
 		},
+		common.NewBatch(id, o),
 		TLSConf(id, o, secret, op),
 	)
 }
@@ -96,6 +100,7 @@ func OutputConf(id string, o logging.OutputSpec, inputs []string, secret *corev1
 		ComponentID:    id,
 		Inputs:         helpers.MakeInputs(inputs...),
 		Region:         region,
+		Compression:    common.Compression(o, "none"),
 		SecurityConfig: SecurityConfig(secret),
 		EndpointConfig: EndpointConfig(o),
 	}
@@ -116,7 +121,7 @@ func SecurityConfig(secret *corev1.Secret) Element {
 }
 
 func TLSConf(id string, o logging.OutputSpec, secret *corev1.Secret, op Options) []Element {
-	if o.Secret != nil {
+	if o.Secret != nil || o.TLS.HasReferences() {
 		if tlsConf := common.GenerateTLSConfWithID(id, o, secret, op, false); tlsConf != nil {
 			tlsConf.NeedsEnabled = false
 			return []Element{tlsConf}
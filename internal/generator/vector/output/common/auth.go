@@ -0,0 +1,38 @@
+package common
+
+import (
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+)
+
+// BearerTokenConf is the auth section of a sink sending a bearer token
+type BearerTokenConf struct {
+	ComponentID string
+	Token       string
+}
+
+func (b BearerTokenConf) Name() string {
+	return "bearerTokenAuthConf"
+}
+
+func (b BearerTokenConf) Template() string {
+	return `{{define "` + b.Name() + `" -}}
+# Bearer Auth Config
+[sinks.{{.ComponentID}}.auth]
+strategy = "bearer"
+token = "{{.Token}}"
+{{end}}
+`
+}
+
+// ServiceAccountTokenAuth sends the projected service account token of the output as bearer token
+func ServiceAccountTokenAuth(id string, o logging.OutputSpec) []framework.Element {
+	token := NewServiceAccountTokenSecret(id, o)
+	return []framework.Element{
+		token,
+		BearerTokenConf{
+			ComponentID: id,
+			Token:       token.Token(),
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package common
+
+import (
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/helpers"
+)
+
+type Batch struct {
+	ComponentID string
+	MaxBytes    helpers.OptionalPair
+	MaxEvents   helpers.OptionalPair
+	TimeoutSecs helpers.OptionalPair
+}
+
+// NewBatch section for an output, only when the output tunes batching
+func NewBatch(id string, o logging.OutputSpec) []framework.Element {
+	t := o.Tuning
+	if !t.HasBatch() {
+		return []framework.Element{}
+	}
+	b := Batch{
+		ComponentID: id,
+		MaxBytes:    helpers.NewOptionalPair("max_bytes", nil),
+		MaxEvents:   helpers.NewOptionalPair("max_events", nil),
+		TimeoutSecs: helpers.NewOptionalPair("timeout_secs", nil),
+	}
+	if t.MaxBatchBytes != nil {
+		b.MaxBytes.Value = *t.MaxBatchBytes
+	}
+	if t.MaxBatchEvents != nil {
+		b.MaxEvents.Value = *t.MaxBatchEvents
+	}
+	if t.BatchTimeoutSecs != nil {
+		b.TimeoutSecs.Value = *t.BatchTimeoutSecs
+	}
+	return []framework.Element{b}
+}
+
+func (b Batch) Name() string {
+	return "batch"
+}
+
+func (b Batch) Template() string {
+	return `{{define "` + b.Name() + `" -}}
+[sinks.{{.ComponentID}}.batch]
+{{ .MaxBytes }}
+{{ .MaxEvents }}
+{{ .TimeoutSecs }}
+{{end}}`
+}
+
+// Compression returns the compression codec tuned for the output or the default
+func Compression(o logging.OutputSpec, defaultCodec string) string {
+	if o.Tuning != nil && o.Tuning.Compression != "" {
+		return o.Tuning.Compression
+	}
+	return defaultCodec
+}
@@ -1,15 +1,47 @@
 package common
 
+import (
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+)
+
 type Buffer struct {
 	ComponentID string
+	Type        string
+	MaxEvents   int64
+	MaxSize     int64
 	WhenFull    string
 }
 
-func NewBuffer(id string) Buffer {
-	return Buffer{
+// NewBuffer section for an output, using the buffer tuning of the output if any.
+// Disk buffers are written by the collector under its data_dir
+func NewBuffer(id string, o logging.OutputSpec) Buffer {
+	b := Buffer{
 		ComponentID: id,
-		WhenFull:    "drop_newest",
+		WhenFull:    logging.BufferWhenFullDropNewest,
+	}
+	if o.Tuning == nil || o.Tuning.Buffer == nil {
+		return b
+	}
+	spec := o.Tuning.Buffer
+	if spec.WhenFull != "" {
+		b.WhenFull = spec.WhenFull
+	}
+	switch spec.Type {
+	case logging.BufferTypeDisk:
+		b.Type = spec.Type
+		if spec.MaxSize != nil {
+			b.MaxSize = spec.MaxSize.Value()
+		}
+	case logging.BufferTypeMemory:
+		b.Type = spec.Type
+		fallthrough
+	default:
+		if spec.MaxEvents != nil {
+			b.Type = logging.BufferTypeMemory
+			b.MaxEvents = *spec.MaxEvents
+		}
 	}
+	return b
 }
 
 func (b Buffer) Name() string {
@@ -19,6 +51,15 @@ func (b Buffer) Name() string {
 func (b Buffer) Template() string {
 	return `{{define "` + b.Name() + `" -}}
 [sinks.{{.ComponentID}}.buffer]
+{{- if .Type }}
+type = "{{.Type}}"
+{{- end }}
+{{- if .MaxEvents }}
+max_events = {{.MaxEvents}}
+{{- end }}
+{{- if .MaxSize }}
+max_size = {{.MaxSize}}
+{{- end }}
 when_full = "{{.WhenFull}}"
 {{end}}`
 }
@@ -0,0 +1,35 @@
+package common
+
+import (
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = DescribeTable("#NewBuffer", func(tuning *logging.OutputTuningSpec, exp string) {
+	Expect(exp).To(EqualConfigFrom(NewBuffer("my_output", logging.OutputSpec{Tuning: tuning})))
+},
+	Entry("should drop newest records by default", nil, `
+[sinks.my_output.buffer]
+when_full = "drop_newest"
+`),
+	Entry("should spec a memory buffer", &logging.OutputTuningSpec{
+		Buffer: &logging.OutputBufferSpec{MaxEvents: utils.GetPtr(int64(1000)), WhenFull: logging.BufferWhenFullBlock},
+	}, `
+[sinks.my_output.buffer]
+type = "memory"
+max_events = 1000
+when_full = "block"
+`),
+	Entry("should spec a disk buffer", &logging.OutputTuningSpec{
+		Buffer: &logging.OutputBufferSpec{Type: logging.BufferTypeDisk, MaxSize: utils.GetPtr(resource.MustParse("1Gi")), WhenFull: logging.BufferWhenFullBlock},
+	}, `
+[sinks.my_output.buffer]
+type = "disk"
+max_size = 1073741824
+when_full = "block"
+`),
+)
@@ -1,28 +1,51 @@
 package common
 
 import (
+	"strconv"
+
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/generator/utils"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
 )
 
 type Request struct {
-	ComponentID   string
-	RetryAttempts int
-	Concurrency   helpers.OptionalPair
-	TimeoutSecs   helpers.OptionalPair
-	headers       map[string]string
+	ComponentID         string
+	RetryAttempts       int
+	Concurrency         helpers.OptionalPair
+	TimeoutSecs         helpers.OptionalPair
+	RetryInitialBackoff helpers.OptionalPair
+	RetryMaxDuration    helpers.OptionalPair
+	headers             map[string]string
 }
 
-// NewRequest section for an output
+// NewRequest section for an output, using the request tuning of the output if any.
 // Ref: LOG-4536 for RetryAttempts default
-func NewRequest(id string) *Request {
-	return &Request{
-		ComponentID:   id,
-		RetryAttempts: 17,
-		Concurrency:   helpers.NewOptionalPair("concurrency", nil),
-		TimeoutSecs:   helpers.NewOptionalPair("timeout_secs", nil),
+func NewRequest(id string, o logging.OutputSpec) *Request {
+	r := &Request{
+		ComponentID:         id,
+		RetryAttempts:       17,
+		Concurrency:         helpers.NewOptionalPair("concurrency", nil),
+		TimeoutSecs:         helpers.NewOptionalPair("timeout_secs", nil),
+		RetryInitialBackoff: helpers.NewOptionalPair("retry_initial_backoff_secs", nil),
+		RetryMaxDuration:    helpers.NewOptionalPair("retry_max_duration_secs", nil),
+	}
+	if t := o.Tuning; t != nil {
+		if t.Concurrency != "" {
+			if n, err := strconv.Atoi(t.Concurrency); err == nil {
+				r.Concurrency.Value = n
+			} else {
+				r.Concurrency.Value = t.Concurrency
+			}
+		}
+		if t.MinRetryDurationSecs != nil {
+			r.RetryInitialBackoff.Value = *t.MinRetryDurationSecs
+		}
+		if t.MaxRetryDurationSecs != nil {
+			r.RetryMaxDuration.Value = *t.MaxRetryDurationSecs
+		}
 	}
+	return r
 }
 
 func (r *Request) Name() string {
@@ -33,8 +56,10 @@ func (r *Request) Template() string {
 	return `{{define "` + r.Name() + `" -}}
 [sinks.{{.ComponentID}}.request]
 retry_attempts = {{.RetryAttempts}}
-{{ .Concurrency -}}
+{{ .Concurrency }}
 {{ .TimeoutSecs }}
+{{ .RetryInitialBackoff }}
+{{ .RetryMaxDuration }}
 {{kv .Headers }}
 {{end}}
 `
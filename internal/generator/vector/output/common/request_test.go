@@ -0,0 +1,46 @@
+package common
+
+import (
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = DescribeTable("#NewRequest", func(tuning *logging.OutputTuningSpec, exp string) {
+	Expect(exp).To(EqualConfigFrom(NewRequest("my_output", logging.OutputSpec{Tuning: tuning})))
+},
+	Entry("should only retry by default", nil, `
+[sinks.my_output.request]
+retry_attempts = 17
+`),
+	Entry("should spec a fixed concurrency and retry durations", &logging.OutputTuningSpec{
+		Concurrency: "4", MinRetryDurationSecs: utils.GetPtr(int64(5)), MaxRetryDurationSecs: utils.GetPtr(int64(60)),
+	}, `
+[sinks.my_output.request]
+retry_attempts = 17
+concurrency = 4
+retry_initial_backoff_secs = 5
+retry_max_duration_secs = 60
+`),
+	Entry("should spec adaptive concurrency", &logging.OutputTuningSpec{Concurrency: "adaptive"}, `
+[sinks.my_output.request]
+retry_attempts = 17
+concurrency = "adaptive"
+`),
+)
+
+var _ = DescribeTable("#NewBatch", func(tuning *logging.OutputTuningSpec, exp string) {
+	Expect(exp).To(EqualConfigFrom(NewBatch("my_output", logging.OutputSpec{Tuning: tuning})))
+},
+	Entry("should not spec a batch by default", nil, ``),
+	Entry("should spec the tuned batch", &logging.OutputTuningSpec{
+		MaxBatchBytes: utils.GetPtr(int64(1048576)), MaxBatchEvents: utils.GetPtr(int64(500)), BatchTimeoutSecs: utils.GetPtr(int64(2)),
+	}, `
+[sinks.my_output.batch]
+max_bytes = 1048576
+max_events = 500
+timeout_secs = 2
+`),
+)
@@ -1,9 +1,12 @@
 package common
 
 import (
+	"encoding/hex"
 	"fmt"
+	"hash/fnv"
 	"net/url"
 	"path/filepath"
+	"strings"
 
 	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
@@ -13,6 +16,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 type TLS bool
@@ -82,6 +86,9 @@ func addTLSSettings(o logging.OutputSpec, secret *corev1.Secret, conf *TLSConf)
 		addTLS = true
 		conf.PassPhrase = security.GetFromSecret(secret, constants.Passphrase)
 	}
+	if addTLSReferences(o, conf) {
+		addTLS = true
+	}
 	if conf.TlsMinVersion != "" || conf.CipherSuites != "" {
 		addTLS = true
 	}
@@ -92,6 +99,24 @@ func addTLSSettings(o logging.OutputSpec, secret *corev1.Secret, conf *TLSConf)
 	return addTLS
 }
 
+// addTLSReferences sets the TLS values referenced independently of the output secret, overriding the secret keys
+func addTLSReferences(o logging.OutputSpec, conf *TLSConf) bool {
+	if !o.TLS.HasReferences() {
+		return false
+	}
+	if o.TLS.CA != nil {
+		conf.CAFilePath = ValuePath(*o.TLS.CA)
+	}
+	if o.TLS.Certificate != nil && o.TLS.Key != nil {
+		conf.CertPath = ValuePath(*o.TLS.Certificate)
+		conf.KeyPath = SecretPath(o.TLS.Key.SecretName, o.TLS.Key.Key)
+	}
+	if o.TLS.KeyPassphrase != nil {
+		conf.PassPhrase = fmt.Sprintf("${%s}", TLSKeyPassphraseEnvVar(o.Name))
+	}
+	return true
+}
+
 func (t TLSConf) Name() string {
 	return "vectorTLS"
 }
@@ -126,6 +151,63 @@ key_pass = "{{ .PassPhrase }}"
 {{ end }}`
 }
 
+// ServiceAccountTokenSecret reads the projected service account token of an output as secret of the collector
+type ServiceAccountTokenSecret struct {
+	ComponentID string
+	Path        string
+}
+
+// NewServiceAccountTokenSecret for the projected service account token of an output
+func NewServiceAccountTokenSecret(id string, o logging.OutputSpec) ServiceAccountTokenSecret {
+	return ServiceAccountTokenSecret{
+		ComponentID: helpers.MakeID(id, "sa_token"),
+		Path:        ServiceAccountTokenDir(o.Name),
+	}
+}
+
+func (s ServiceAccountTokenSecret) Name() string {
+	return "serviceAccountTokenSecret"
+}
+
+func (s ServiceAccountTokenSecret) Template() string {
+	return `{{define "` + s.Name() + `" -}}
+[secret.{{.ComponentID}}]
+type = "directory"
+path = "{{.Path}}"
+remove_trailing_whitespace = true
+{{end}}
+`
+}
+
+// Token is the reference to the token in the collector configuration, resolved when the configuration is loaded
+func (s ServiceAccountTokenSecret) Token() string {
+	return fmt.Sprintf("SECRET[%s.%s]", s.ComponentID, constants.BearerTokenFileKey)
+}
+
+const serviceAccountTokenVolumePrefix = "sa-token-"
+
+// ServiceAccountTokenVolumeName is the name of the projected service account token volume of an output.
+// Output names that change when formatted as volume name or are too long are suffixed with a hash of the name
+// to keep the volume names unique and within the length of a DNS label
+func ServiceAccountTokenVolumeName(outputName string) string {
+	name := strings.ReplaceAll(helpers.FormatComponentID(outputName), "_", "-")
+	if name == outputName && len(serviceAccountTokenVolumePrefix+name) <= validation.DNS1123LabelMaxLength {
+		return serviceAccountTokenVolumePrefix + name
+	}
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(outputName))
+	suffix := fmt.Sprintf("-%08x", hash.Sum32())
+	if max := validation.DNS1123LabelMaxLength - len(serviceAccountTokenVolumePrefix) - len(suffix); len(name) > max {
+		name = name[:max]
+	}
+	return serviceAccountTokenVolumePrefix + strings.TrimRight(name, "-") + suffix
+}
+
+// ServiceAccountTokenDir is the directory of the projected service account token of an output in the collector
+func ServiceAccountTokenDir(outputName string) string {
+	return filepath.Join(constants.CollectorServiceAccountTokensDir, ServiceAccountTokenVolumeName(outputName))
+}
+
 var NoSecrets = map[string]*corev1.Secret{}
 
 func HasUsernamePassword(secret *corev1.Secret) bool {
@@ -184,6 +266,25 @@ func SecretPath(name string, file string) string {
 	return fmt.Sprintf("%q", filepath.Join("/var/run/ocp-collector/secrets", name, file))
 }
 
+// ConfigMapPath is the quoted path of a configmap key mounted in the collector
+func ConfigMapPath(name string, file string) string {
+	return fmt.Sprintf("%q", filepath.Join(constants.CollectorConfigMapsDir, name, file))
+}
+
+// ValuePath is the quoted path of a referenced configmap or secret key mounted in the collector
+func ValuePath(ref logging.ValueReference) string {
+	if ref.ConfigMapName != "" {
+		return ConfigMapPath(ref.ConfigMapName, ref.Key)
+	}
+	return SecretPath(ref.SecretName, ref.Key)
+}
+
+// TLSKeyPassphraseEnvVar is the collector environment variable holding the referenced TLS key passphrase of an output.
+// The output name is hex encoded since component IDs of different names may be equal (e.g. my-out and my_out)
+func TLSKeyPassphraseEnvVar(outputName string) string {
+	return "TLS_KEY_PASSPHRASE_" + strings.ToUpper(hex.EncodeToString([]byte(outputName)))
+}
+
 // TryKeys try keys in turn return data for fist one present with ok=true.
 // If none present return ok=false.
 func TryKeys(secret *corev1.Secret, keys ...string) (data []byte, ok bool) {
@@ -0,0 +1,114 @@
+package common
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("#GenerateTLSConfWithID", func() {
+
+	var (
+		output logging.OutputSpec
+		secret = &corev1.Secret{
+			Data: map[string][]byte{
+				"tls.crt":       []byte("cert"),
+				"tls.key":       []byte("key"),
+				"ca-bundle.crt": []byte("ca"),
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		output = logging.OutputSpec{
+			Name:   "my-output",
+			URL:    "https://somewhere",
+			Secret: &logging.OutputSecretSpec{Name: "my-secret"},
+		}
+	})
+
+	It("should use the keys of the output secret", func() {
+		Expect(`
+[sinks.my_output.tls]
+enabled = true
+key_file = "/var/run/ocp-collector/secrets/my-secret/tls.key"
+crt_file = "/var/run/ocp-collector/secrets/my-secret/tls.crt"
+ca_file = "/var/run/ocp-collector/secrets/my-secret/ca-bundle.crt"
+`).To(EqualConfigFrom(GenerateTLSConfWithID("my_output", output, secret, framework.NoOptions, false)))
+	})
+
+	It("should override the keys of the output secret with references", func() {
+		output.TLS = &logging.OutputTLSSpec{
+			CA:            &logging.ValueReference{Key: "service-ca.crt", ConfigMapName: "pki-ca"},
+			Certificate:   &logging.ValueReference{Key: "tls.crt", SecretName: "client-cert"},
+			Key:           &logging.SecretReference{Key: "tls.key", SecretName: "client-cert"},
+			KeyPassphrase: &logging.SecretReference{Key: "passphrase", SecretName: "client-cert"},
+		}
+		Expect(`
+[sinks.my_output.tls]
+enabled = true
+key_file = "/var/run/ocp-collector/secrets/client-cert/tls.key"
+crt_file = "/var/run/ocp-collector/secrets/client-cert/tls.crt"
+ca_file = "/var/run/ocp-collector/configmaps/pki-ca/service-ca.crt"
+key_pass = "${TLS_KEY_PASSPHRASE_6D792D6F7574707574}"
+`).To(EqualConfigFrom(GenerateTLSConfWithID("my_output", output, secret, framework.NoOptions, false)))
+	})
+
+	It("should use references without an output secret", func() {
+		output.Secret = nil
+		output.TLS = &logging.OutputTLSSpec{
+			CA: &logging.ValueReference{Key: "ca.crt", SecretName: "pki-ca"},
+		}
+		Expect(`
+[sinks.my_output.tls]
+enabled = true
+ca_file = "/var/run/ocp-collector/secrets/pki-ca/ca.crt"
+`).To(EqualConfigFrom(GenerateTLSConfWithID("my_output", output, nil, framework.NoOptions, false)))
+	})
+
+	It("should use distinct passphrase env vars for outputs with the same component ID", func() {
+		Expect(TLSKeyPassphraseEnvVar("my-out")).ToNot(Equal(TLSKeyPassphraseEnvVar("my_out")))
+	})
+})
+
+var _ = Describe("#ServiceAccountTokenVolumeName", func() {
+	It("should use the output name if it is a valid volume name", func() {
+		Expect(ServiceAccountTokenVolumeName("my-http")).To(Equal("sa-token-my-http"))
+	})
+	It("should add a hash to formatted names to keep them unique", func() {
+		Expect(ServiceAccountTokenVolumeName("my_http")).To(MatchRegexp(`^sa-token-my-http-[0-9a-f]{8}$`))
+		Expect(ServiceAccountTokenVolumeName("my_http")).ToNot(Equal(ServiceAccountTokenVolumeName("my.http")))
+	})
+	It("should shorten long names to a DNS label", func() {
+		long := strings.Repeat("a", 60)
+		name := ServiceAccountTokenVolumeName(long)
+		Expect(len(name)).To(Equal(63))
+		Expect(name).To(MatchRegexp(`^sa-token-a+-[0-9a-f]{8}$`))
+		Expect(name).ToNot(Equal(ServiceAccountTokenVolumeName(long + "b")))
+	})
+})
+
+var _ = Describe("#ServiceAccountTokenAuth", func() {
+	It("should send the projected token as bearer token", func() {
+		output := logging.OutputSpec{
+			Name:                "my-output",
+			ServiceAccountToken: &logging.ServiceAccountToken{Audience: "logs"},
+		}
+		Expect(`
+[secret.my_output_sa_token]
+type = "directory"
+path = "/var/run/ocp-collector/serviceaccount/sa-token-my-output"
+remove_trailing_whitespace = true
+
+# Bearer Auth Config
+[sinks.my_output.auth]
+strategy = "bearer"
+token = "SECRET[my_output_sa_token.token]"
+`).To(EqualConfigFrom(ServiceAccountTokenAuth("my_output", output)))
+	})
+})
@@ -0,0 +1,31 @@
+package elasticsearch
+
+type AWSAuth struct {
+	Desc        string
+	ComponentID string
+	Region      string
+	KeyID       string
+	KeySecret   string
+	KeyRoleArn  string
+}
+
+func (a AWSAuth) Name() string {
+	return "elasticsearchAWSAuthTemplate"
+}
+
+func (a AWSAuth) Template() string {
+	return `{{define "` + a.Name() + `" -}}
+# {{.Desc}}
+[sinks.{{.ComponentID}}.auth]
+strategy = "aws"
+{{- if .KeyRoleArn }}
+# role_arn and identity token set via env vars
+{{- else }}
+access_key_id = "{{.KeyID}}"
+secret_access_key = "{{.KeySecret}}"
+{{- end }}
+
+[sinks.{{.ComponentID}}.aws]
+region = "{{.Region}}"
+{{- end}}`
+}
@@ -11,9 +11,16 @@ import (
 	genhelper "github.com/openshift/cluster-logging-operator/internal/generator/helpers"
 	. "github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/cloudwatch"
 	corev1 "k8s.io/api/core/v1"
 )
 
+const (
+	defaultDataStreamType      = "logs"
+	defaultDataStreamDataset   = `{.log_type||"unknown"}`
+	defaultDataStreamNamespace = "default"
+)
+
 type Elasticsearch struct {
 	ID_Key      string
 	Desc        string
@@ -22,6 +29,10 @@ type Elasticsearch struct {
 	Index       string
 	Endpoint    string
 	Version     int
+	Compression string
+	BulkAction  string
+	DataStream  bool
+	Pipeline    string
 }
 
 func (e Elasticsearch) Name() string {
@@ -34,13 +45,23 @@ func (e Elasticsearch) Template() string {
 type = "elasticsearch"
 inputs = {{.Inputs}}
 endpoints = ["{{.Endpoint}}"]
+{{- if .DataStream }}
+mode = "data_stream"
+{{- else }}
 bulk.index = "{{ "{{ write_index }}" }}"
-bulk.action = "create"
+{{- end }}
+bulk.action = "{{.BulkAction}}"
+{{- if .Pipeline }}
+pipeline = {{.Pipeline}}
+{{- end }}
 encoding.except_fields = ["write_index"]
 id_key = "_id"
 {{- if ne .Version 0 }}
 api_version = "v{{ .Version }}"
 {{- end }}
+{{- if .Compression }}
+compression = "{{.Compression}}"
+{{- end }}
 {{end}}`
 }
 
@@ -60,6 +81,12 @@ if (.log_type == "audit"){
 .write_index = index + "-write"`
 
 	addESId := `._id = encode_base64(uuid_v4())`
+	if o.Elasticsearch != nil && o.Elasticsearch.IDField != "" {
+		addESId = fmt.Sprintf(`._id = to_string(%s) ?? ""
+if ._id == "" {
+  ._id = encode_base64(uuid_v4())
+}`, o.Elasticsearch.IDField)
+	}
 	removeFile := `del(.file)`
 	removeTag := `del(.tag)`
 	removeSourceType := `del(.source_type)`
@@ -118,6 +145,9 @@ if .log_type == "application" && .structured != null {
     del(.structured)
   }
 `)
+		if es.BulkMode == logging.ElasticsearchBulkModeDataStream {
+			vrls = append(vrls, DataStream(es.DataStream))
+		}
 	}
 
 	return Remap{
@@ -128,6 +158,27 @@ if .log_type == "application" && .structured != null {
 	}
 }
 
+// DataStream returns VRL setting the data_stream fields of each record, which route the record to its data stream
+func DataStream(ds *logging.ElasticsearchDataStream) string {
+	dsType, dataset, namespace := defaultDataStreamType, defaultDataStreamDataset, defaultDataStreamNamespace
+	if ds != nil {
+		if ds.Type != "" {
+			dsType = ds.Type
+		}
+		if ds.Dataset != "" {
+			dataset = ds.Dataset
+		}
+		if ds.Namespace != "" {
+			namespace = ds.Namespace
+		}
+	}
+	return strings.Join([]string{
+		".data_stream.type = " + helpers.TemplateToVRL(dsType),
+		".data_stream.dataset = " + helpers.TemplateToVRL(dataset),
+		".data_stream.namespace = " + helpers.TemplateToVRL(namespace),
+	}, "\n")
+}
+
 func FlattenLabels(id string, inputs []string) Element {
 	return ConfLiteral{
 		ComponentID:  id,
@@ -224,18 +275,31 @@ func New(id string, o logging.OutputSpec, inputs []string, secret *corev1.Secret
 			Debug(id, helpers.MakeInputs([]string{dedotID}...)),
 		}
 	}
-	request := common.NewRequest(id)
+	request := common.NewRequest(id, o)
 	request.TimeoutSecs.Value = 2147483648
+	if o.Elasticsearch != nil && o.Elasticsearch.Auth != nil && o.Elasticsearch.Auth.Type == logging.ElasticsearchAuthTypeAPIKey {
+		request.SetHeaders(map[string]string{
+			"Authorization": "ApiKey " + strings.TrimSpace(common.GetFromSecret(secret, constants.ElasticsearchAPIKey)),
+		})
+	}
+	if o.ServiceAccountToken != nil {
+		token := common.NewServiceAccountTokenSecret(id, o)
+		outputs = append(outputs, token)
+		request.SetHeaders(map[string]string{
+			"Authorization": "Bearer " + token.Token(),
+		})
+	}
 	outputs = MergeElements(outputs,
 		[]Element{
 			SetESIndex(esIndexID, inputs, o, op),
 			FlattenLabels(dedotID, []string{esIndexID}),
 			Output(id, o, []string{dedotID}, secret, op),
-			common.NewBuffer(id),
+			common.NewBuffer(id, o),
 			request,
 		},
+		common.NewBatch(id, o),
 		TLSConf(id, o, secret, op),
-		BasicAuth(id, o, secret),
+		Auth(id, o, secret),
 	)
 
 	return outputs
@@ -246,6 +310,20 @@ func Output(id string, o logging.OutputSpec, inputs []string, secret *corev1.Sec
 		ComponentID: id,
 		Endpoint:    o.URL,
 		Inputs:      helpers.MakeInputs(inputs...),
+		Compression: common.Compression(o, ""),
+		BulkAction:  logging.ElasticsearchBulkModeCreate,
+	}
+	if o.Elasticsearch != nil {
+		switch o.Elasticsearch.BulkMode {
+		case logging.ElasticsearchBulkModeIndex:
+			es.BulkAction = logging.ElasticsearchBulkModeIndex
+		case logging.ElasticsearchBulkModeDataStream:
+			// Data streams only accept the create action
+			es.DataStream = true
+		}
+		if o.Elasticsearch.Pipeline != "" {
+			es.Pipeline = fmt.Sprintf("%q", o.Elasticsearch.Pipeline)
+		}
 	}
 	// If valid version is specified
 	if o.Elasticsearch != nil && o.Elasticsearch.Version > 0 {
@@ -257,7 +335,7 @@ func Output(id string, o logging.OutputSpec, inputs []string, secret *corev1.Sec
 }
 
 func TLSConf(id string, o logging.OutputSpec, secret *corev1.Secret, op Options) []Element {
-	if o.Secret != nil {
+	if o.Secret != nil || o.TLS.HasReferences() {
 		if tlsConf := common.GenerateTLSConfWithID(id, o, secret, op, false); tlsConf != nil {
 			tlsConf.NeedsEnabled = false
 			return []Element{tlsConf}
@@ -266,6 +344,32 @@ func TLSConf(id string, o logging.OutputSpec, secret *corev1.Secret, op Options)
 	return []Element{}
 }
 
+// Auth returns the authentication of the sink. An API key or service account token is sent as a request header instead
+func Auth(id string, o logging.OutputSpec, secret *corev1.Secret) []Element {
+	if o.ServiceAccountToken != nil {
+		return []Element{}
+	}
+	if o.Elasticsearch == nil || o.Elasticsearch.Auth == nil {
+		return BasicAuth(id, o, secret)
+	}
+	auth := o.Elasticsearch.Auth
+	if auth.Type != logging.ElasticsearchAuthTypeAWS {
+		return []Element{}
+	}
+	aws := AWSAuth{
+		Desc:        "AWS Auth Config",
+		ComponentID: id,
+		Region:      auth.Region,
+	}
+	if common.HasAwsRoleArnKey(secret) || common.HasAwsCredentialsKey(secret) {
+		aws.KeyRoleArn = cloudwatch.ParseRoleArn(secret)
+	} else {
+		aws.KeyID = strings.TrimSpace(common.GetFromSecret(secret, constants.AWSAccessKeyID))
+		aws.KeySecret = strings.TrimSpace(common.GetFromSecret(secret, constants.AWSSecretAccessKey))
+	}
+	return []Element{aws}
+}
+
 func BasicAuth(id string, o logging.OutputSpec, secret *corev1.Secret) []Element {
 	conf := []Element{}
 
@@ -5,12 +5,16 @@ import (
 	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/normalize"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/azuremonitor"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/cloudwatch"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/elasticsearch"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/gcl"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/http"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/kafka"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/loki"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/lokistack"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/otlp"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/s3"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/splunk"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/syslog"
 	corev1 "k8s.io/api/core/v1"
@@ -34,16 +38,24 @@ func New(o logging.OutputSpec, inputs []string, secrets map[string]*corev1.Secre
 		els = append(els, kafka.New(baseID, o, inputs, secret, op)...)
 	case logging.OutputTypeLoki:
 		els = append(els, loki.New(baseID, o, inputs, secret, op)...)
+	case logging.OutputTypeLokiStack:
+		els = append(els, lokistack.New(baseID, o, inputs, secret, op)...)
 	case logging.OutputTypeElasticsearch:
 		els = append(els, elasticsearch.New(baseID, o, inputs, secret, op)...)
 	case logging.OutputTypeCloudwatch:
 		els = append(els, cloudwatch.New(baseID, o, inputs, secret, op)...)
+	case logging.OutputTypeS3:
+		els = append(els, s3.New(baseID, o, inputs, secret, op)...)
+	case logging.OutputTypeAzureMonitor:
+		els = append(els, azuremonitor.New(baseID, o, inputs, secret, op)...)
 	case logging.OutputTypeGoogleCloudLogging:
 		els = append(els, gcl.New(baseID, o, inputs, secret, op)...)
 	case logging.OutputTypeSplunk:
 		els = append(els, splunk.New(baseID, o, inputs, secret, op)...)
 	case logging.OutputTypeHttp:
 		els = append(els, http.New(baseID, o, inputs, secret, op)...)
+	case logging.OutputTypeOTLP:
+		els = append(els, otlp.New(baseID, o, inputs, secret, op)...)
 	case logging.OutputTypeSyslog:
 		els = append(els, syslog.New(baseID, o, inputs, secret, op)...)
 	}
@@ -88,15 +88,16 @@ func New(id string, o logging.OutputSpec, inputs []string, secret *corev1.Secret
 		[]Element{
 			normalize.DedotLabels(dedottedID, inputs),
 			gcl,
-			common.NewBuffer(id),
-			common.NewRequest(id),
+			common.NewBuffer(id, o),
+			common.NewRequest(id, o),
 		},
+		common.NewBatch(id, o),
 		TLSConf(id, o, secret, op),
 	)
 }
 
 func TLSConf(id string, o logging.OutputSpec, secret *corev1.Secret, op Options) []Element {
-	if o.Secret != nil {
+	if o.Secret != nil || o.TLS.HasReferences() {
 		if tlsConf := common.GenerateTLSConfWithID(id, o, secret, op, false); tlsConf != nil {
 			tlsConf.NeedsEnabled = false
 			return []Element{tlsConf}
@@ -2,6 +2,8 @@ package http
 
 import (
 	"fmt"
+	"strings"
+
 	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
@@ -21,6 +23,14 @@ const (
 
 var (
 	httpEncodingJson = fmt.Sprintf("%q", "json")
+	httpEncodingText = fmt.Sprintf("%q", "text")
+
+	// FormatContentTypes is the Content-Type of the request body of each format
+	FormatContentTypes = map[string]string{
+		logging.HttpFormatJSON:   "application/json",
+		logging.HttpFormatNDJSON: "application/x-ndjson",
+		logging.HttpFormatText:   "text/plain",
+	}
 )
 
 type Http struct {
@@ -28,6 +38,7 @@ type Http struct {
 	Inputs      string
 	URI         string
 	Method      string
+	Compression string
 }
 
 func (h Http) Name() string {
@@ -41,6 +52,9 @@ type = "http"
 inputs = {{.Inputs}}
 uri = "{{.URI}}"
 method = "{{.Method}}"
+{{- if .Compression }}
+compression = "{{.Compression}}"
+{{- end }}
 {{end}}
 `
 }
@@ -48,6 +62,7 @@ method = "{{.Method}}"
 type HttpEncoding struct {
 	ComponentID string
 	Codec       string
+	Framing     string
 }
 
 func (h HttpEncoding) Name() string {
@@ -58,6 +73,11 @@ func (h HttpEncoding) Template() string {
 	return `{{define "` + h.Name() + `" -}}
 [sinks.{{.ComponentID}}.encoding]
 codec = {{.Codec}}
+{{- if .Framing }}
+
+[sinks.{{.ComponentID}}.framing]
+method = {{.Framing}}
+{{- end }}
 {{end}}`
 }
 
@@ -120,19 +140,25 @@ func New(id string, o logging.OutputSpec, inputs []string, secret *corev1.Secret
 		inputs = []string{schemaID}
 	}
 	els = append(els, Normalize(normalizeID, inputs))
+	dedotInputs := []string{normalizeID}
+	if field := textField(o.Http); field != ".message" {
+		textID := vectorhelpers.MakeID(id, "text")
+		els = append(els, TextMessage(textID, field, dedotInputs))
+		dedotInputs = []string{textID}
+	}
 	return MergeElements(
 
 		els,
 		[]Element{
-			normalize.DedotLabels(dedottedID, []string{normalizeID}),
+			normalize.DedotLabels(dedottedID, dedotInputs),
 			Output(id, o, []string{dedottedID}, secret, op),
-			Encoding(id),
-			common.NewBuffer(id),
+			Encoding(id, o),
+			common.NewBuffer(id, o),
 			Request(id, o),
 		},
+		common.NewBatch(id, o),
 		TLSConf(id, o, secret, op),
-		BasicAuth(id, o, secret),
-		BearerTokenAuth(id, o, secret),
+		Auth(id, o, secret),
 	)
 }
 
@@ -142,6 +168,7 @@ func Output(id string, o logging.OutputSpec, inputs []string, secret *corev1.Sec
 		Inputs:      vectorhelpers.MakeInputs(inputs...),
 		URI:         o.URL,
 		Method:      Method(o.Http),
+		Compression: common.Compression(o, ""),
 	}
 }
 
@@ -175,15 +202,78 @@ func Request(id string, o logging.OutputSpec) *common.Request {
 	if o.Http != nil && o.Http.Timeout != 0 {
 		timeout = o.Http.Timeout
 	}
-	req := common.NewRequest(id)
+	req := common.NewRequest(id, o)
 	req.TimeoutSecs.Value = timeout
 	if o.Http != nil && len(o.Http.Headers) != 0 {
-		req.SetHeaders(o.Http.Headers)
+		// The collector sets the Content-Type of a spec'd format
+		headers := map[string]string{}
+		for name, value := range o.Http.Headers {
+			if o.Http.Format == "" || !strings.EqualFold(name, contentTypeHeader) {
+				headers[name] = value
+			}
+		}
+		if len(headers) != 0 {
+			req.SetHeaders(headers)
+		}
 	}
 	return req
 }
 
-func Encoding(id string) Element {
+const contentTypeHeader = "Content-Type"
+
+// ContentType returns the value of the Content-Type header, matching the header name case-insensitively
+func ContentType(h *logging.Http) (string, bool) {
+	if h == nil {
+		return "", false
+	}
+	for name, value := range h.Headers {
+		if strings.EqualFold(name, contentTypeHeader) {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// Format returns the spec'd format, defaulting to json. The Content-Type header does not change the format
+// to keep the encoding of outputs without a format
+func Format(h *logging.Http) string {
+	if h != nil && h.Format != "" {
+		return h.Format
+	}
+	return logging.HttpFormatJSON
+}
+
+func textField(h *logging.Http) string {
+	if Format(h) != logging.HttpFormatText || h.TextField == "" {
+		return ".message"
+	}
+	return h.TextField
+}
+
+// TextMessage copies the text field to the message sent by the text format
+func TextMessage(id, field string, inputs []string) Element {
+	return Remap{
+		ComponentID: id,
+		Inputs:      helpers.MakeInputs(inputs...),
+		VRL:         fmt.Sprintf(".message = string(%s) ?? encode_json(%s)", field, field),
+	}
+}
+
+func Encoding(id string, o logging.OutputSpec) Element {
+	switch Format(o.Http) {
+	case logging.HttpFormatNDJSON:
+		return HttpEncoding{
+			ComponentID: id,
+			Codec:       httpEncodingJson,
+			Framing:     fmt.Sprintf("%q", "newline_delimited"),
+		}
+	case logging.HttpFormatText:
+		return HttpEncoding{
+			ComponentID: id,
+			Codec:       httpEncodingText,
+			Framing:     fmt.Sprintf("%q", "newline_delimited"),
+		}
+	}
 	return HttpEncoding{
 		ComponentID: id,
 		Codec:       httpEncodingJson,
@@ -191,7 +281,7 @@ func Encoding(id string) Element {
 }
 
 func TLSConf(id string, o logging.OutputSpec, secret *corev1.Secret, op Options) []Element {
-	if o.Secret != nil {
+	if o.Secret != nil || o.TLS.HasReferences() {
 		if tlsConf := common.GenerateTLSConfWithID(id, o, secret, op, false); tlsConf != nil {
 			tlsConf.NeedsEnabled = false
 			return []Element{tlsConf}
@@ -200,6 +290,17 @@ func TLSConf(id string, o logging.OutputSpec, secret *corev1.Secret, op Options)
 	return []Element{}
 }
 
+// Auth is the service account token authentication when configured, else basic or bearer token authentication
+func Auth(id string, o logging.OutputSpec, secret *corev1.Secret) []Element {
+	if o.ServiceAccountToken != nil {
+		return common.ServiceAccountTokenAuth(id, o)
+	}
+	return MergeElements(
+		BasicAuth(id, o, secret),
+		BearerTokenAuth(id, o, secret),
+	)
+}
+
 func BasicAuth(id string, o logging.OutputSpec, secret *corev1.Secret) []Element {
 	conf := []Element{}
 
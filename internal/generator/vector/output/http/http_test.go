@@ -790,6 +790,150 @@ password = "password"
 	)
 })
 
+var _ = Describe("Http output format", func() {
+	DescribeTable("#Format", func(h *logging.Http, exp string) {
+		Expect(Format(h)).To(Equal(exp))
+	},
+		Entry("should default to json", nil, logging.HttpFormatJSON),
+		Entry("should use the spec'd format", &logging.Http{Format: logging.HttpFormatText}, logging.HttpFormatText),
+		Entry("should not derive the format from the Content-Type header", &logging.Http{Headers: map[string]string{"content-type": "application/x-ndjson"}}, logging.HttpFormatJSON),
+	)
+
+	DescribeTable("#Encoding should set the codec and framing", func(h *logging.Http, exp string) {
+		Expect(exp).To(EqualConfigFrom(Encoding("http_receiver", logging.OutputSpec{OutputTypeSpec: logging.OutputTypeSpec{Http: h}})))
+	},
+		Entry("for a JSON array", &logging.Http{Format: logging.HttpFormatJSON}, `
+[sinks.http_receiver.encoding]
+codec = "json"
+`),
+		Entry("for NDJSON", &logging.Http{Format: logging.HttpFormatNDJSON}, `
+[sinks.http_receiver.encoding]
+codec = "json"
+
+[sinks.http_receiver.framing]
+method = "newline_delimited"
+`),
+		Entry("for text", &logging.Http{Format: logging.HttpFormatText}, `
+[sinks.http_receiver.encoding]
+codec = "text"
+
+[sinks.http_receiver.framing]
+method = "newline_delimited"
+`),
+	)
+
+	It("should copy the text field to the message", func() {
+		output := logging.OutputSpec{
+			Type: logging.OutputTypeHttp,
+			Name: "http-receiver",
+			URL:  "https://my-logstore.com",
+			OutputTypeSpec: logging.OutputTypeSpec{
+				Http: &logging.Http{
+					Format:    logging.HttpFormatText,
+					TextField: ".kubernetes.pod_name",
+				},
+			},
+		}
+		conf := New("http_receiver", output, []string{"application"}, nil, framework.Options{})
+		Expect(`
+[transforms.http_receiver_text]
+type = "remap"
+inputs = ["http_receiver_normalize"]
+source = '''
+  .message = string(.kubernetes.pod_name) ?? encode_json(.kubernetes.pod_name)
+'''
+`).To(EqualConfigFrom(conf[1]))
+	})
+
+	It("should leave the Content-Type header of a format to the collector", func() {
+		output := logging.OutputSpec{
+			OutputTypeSpec: logging.OutputTypeSpec{
+				Http: &logging.Http{
+					Format:  logging.HttpFormatNDJSON,
+					Headers: map[string]string{"Content-Type": "application/x-ndjson", "k1": "v1"},
+				},
+			},
+		}
+		Expect(`
+[sinks.http_receiver.request]
+retry_attempts = 17
+timeout_secs = 10
+headers = {"k1"="v1"}
+`).To(EqualConfigFrom(Request("http_receiver", output)))
+	})
+
+	It("should keep the Content-Type header and the JSON encoding without a format", func() {
+		output := logging.OutputSpec{
+			OutputTypeSpec: logging.OutputTypeSpec{
+				Http: &logging.Http{
+					Headers: map[string]string{"Content-Type": "application/x-ndjson", "k1": "v1"},
+				},
+			},
+		}
+		Expect(`
+[sinks.http_receiver.request]
+retry_attempts = 17
+timeout_secs = 10
+headers = {"Content-Type"="application/x-ndjson","k1"="v1"}
+`).To(EqualConfigFrom(Request("http_receiver", output)))
+		Expect(`
+[sinks.http_receiver.encoding]
+codec = "json"
+`).To(EqualConfigFrom(Encoding("http_receiver", output)))
+	})
+})
+
+var _ = Describe("Http output auth", func() {
+	var (
+		output logging.OutputSpec
+		secret *corev1.Secret
+	)
+	BeforeEach(func() {
+		output = logging.OutputSpec{
+			Type: logging.OutputTypeHttp,
+			Name: "http-receiver",
+			URL:  "https://my-logstore.com",
+			Secret: &logging.OutputSecretSpec{
+				Name: "http-receiver",
+			},
+			OutputTypeSpec: logging.OutputTypeSpec{
+				Http: &logging.Http{},
+			},
+		}
+		secret = &corev1.Secret{
+			Data: map[string][]byte{
+				constants.ClientUsername: []byte("user"),
+				constants.ClientPassword: []byte("pass"),
+			},
+		}
+	})
+
+	It("should use basic auth with the username and password of the secret", func() {
+		Expect(`
+# Basic Auth Config
+[sinks.http_receiver.auth]
+strategy = "basic"
+user = "user"
+password = "pass"
+`).To(EqualConfigFrom(Auth("http_receiver", output, secret)))
+	})
+
+	It("should send the service account token instead of the secret keys", func() {
+		output.ServiceAccountToken = &logging.ServiceAccountToken{Audience: "my-logstore"}
+		Expect(`
+[secret.http_receiver_sa_token]
+type = "directory"
+path = "/var/run/ocp-collector/serviceaccount/sa-token-http-receiver"
+remove_trailing_whitespace = true
+
+# Bearer Auth Config
+[sinks.http_receiver.auth]
+strategy = "bearer"
+token = "SECRET[http_receiver_sa_token.token]"
+`).To(EqualConfigFrom(Auth("http_receiver", output, secret)))
+	})
+})
+
 func TestHeaders(t *testing.T) {
 	h := map[string]string{
 		"k1": "v1",
@@ -20,6 +20,12 @@ import (
 
 const (
 	defaultKafkaTopic = "topic"
+
+	// TopicField is the record field holding the rendered topic template, it is not written to the topic
+	TopicField = "kafka_topic"
+
+	// KeyField is the record field holding the rendered message key template, it is not written to the topic
+	KeyField = "kafka_key"
 )
 
 type Kafka struct {
@@ -28,6 +34,8 @@ type Kafka struct {
 	Inputs           string
 	BootstrapServers string
 	Topic            string
+	KeyField         string
+	Compression      string
 }
 
 func (k Kafka) Name() string {
@@ -42,6 +50,12 @@ type = "kafka"
 inputs = {{.Inputs}}
 bootstrap_servers = {{.BootstrapServers}}
 topic = {{.Topic}}
+{{- if .KeyField }}
+key_field = "{{.KeyField}}"
+{{- end }}
+{{- if .Compression }}
+compression = "{{.Compression}}"
+{{- end }}
 {{end}}
 `
 }
@@ -55,13 +69,23 @@ func New(id string, o logging.OutputSpec, inputs []string, secret *corev1.Secret
 
 	dedottedID := vectorhelpers.MakeID(id, "dedot")
 	brokers, genTlsConf := Brokers(o)
+	elements := []Element{
+		normalize.DedotLabels(dedottedID, inputs),
+	}
+	sinkInputs := []string{dedottedID}
+	if IsTemplated(o) {
+		topicID := vectorhelpers.MakeID(id, "topic")
+		elements = append(elements, TopicAndKey(topicID, []string{dedottedID}, o))
+		sinkInputs = []string{topicID}
+	}
 	return MergeElements(
+		elements,
 		[]Element{
-			normalize.DedotLabels(dedottedID, inputs),
-			Output(id, o, []string{dedottedID}, secret, op, brokers),
-			Encoding(id, op),
-			common.NewBuffer(id),
+			Output(id, o, sinkInputs, secret, op, brokers),
+			Encoding(id, o),
+			common.NewBuffer(id, o),
 		},
+		common.NewBatch(id, o),
 		TLSConf(id, o, secret, op, genTlsConf),
 		SASLConf(id, o, secret),
 	)
@@ -71,12 +95,46 @@ func Output(id string, o logging.OutputSpec, inputs []string, secret *corev1.Sec
 	if genhelper.IsDebugOutput(op) {
 		return genhelper.DebugOutput
 	}
-	return Kafka{
+	kafka := Kafka{
 		Desc:             "Kafka config",
 		ComponentID:      id,
 		Inputs:           vectorhelpers.MakeInputs(inputs...),
 		Topic:            fmt.Sprintf("%q", Topics(o)),
 		BootstrapServers: fmt.Sprintf("%q", brokers),
+		Compression:      common.Compression(o, compression(o)),
+	}
+	if IsTemplated(o) {
+		kafka.Topic = fmt.Sprintf("%q", "{{ "+TopicField+" }}")
+		if o.Kafka.Key != "" {
+			kafka.KeyField = KeyField
+		}
+	}
+	return kafka
+}
+
+func compression(o logging.OutputSpec) string {
+	if o.Kafka != nil && o.Kafka.Compression != "" {
+		return o.Kafka.Compression
+	}
+	return ""
+}
+
+// IsTemplated returns true if the topic references record fields or a message key is configured
+func IsTemplated(o logging.OutputSpec) bool {
+	return o.Kafka != nil && (vectorhelpers.IsTemplate(o.Kafka.Topic) || o.Kafka.Key != "")
+}
+
+// TopicAndKey renders the topic and message key templates of the output into the TopicField and KeyField of each record
+func TopicAndKey(id string, inputs []string, o logging.OutputSpec) Element {
+	vrl := "." + TopicField + " = " + vectorhelpers.TemplateToVRL(Topics(o))
+	if o.Kafka.Key != "" {
+		vrl += "\n." + KeyField + " = " + vectorhelpers.TemplateToVRL(o.Kafka.Key)
+	}
+	return Remap{
+		Desc:        "Kafka Topic and Key",
+		ComponentID: id,
+		Inputs:      vectorhelpers.MakeInputs(inputs...),
+		VRL:         vrl,
 	}
 }
 
@@ -112,6 +170,7 @@ func Brokers(o logging.OutputSpec) (string, bool) {
 // Topic returns the name of an existing kafka topic.
 // The kafka topic is either extracted from the kafka OutputSpec `Topic` field in a multiple broker
 // setup or as a fallback from the OutputSpec URL if provided as a host path. Defaults to `topic`.
+// The `Topic` field may be a template referencing record fields, see TopicAndKey.
 func Topics(o logging.OutputSpec) string {
 	if o.Kafka != nil && o.Kafka.Topic != "" {
 		return o.Kafka.Topic
@@ -127,7 +186,12 @@ func Topics(o logging.OutputSpec) string {
 	return defaultKafkaTopic
 }
 
-func Encoding(id string, op Options) Element {
+func Encoding(id string, o logging.OutputSpec) Element {
+	exceptFields := ""
+	if IsTemplated(o) {
+		exceptFields = `
+except_fields = ["` + TopicField + `","` + KeyField + `"]`
+	}
 	return ConfLiteral{
 		ComponentID:  id,
 		TemplateName: "kafkaEncoding",
@@ -135,38 +199,38 @@ func Encoding(id string, op Options) Element {
 {{define "kafkaEncoding" -}}
 [sinks.{{.ComponentID}}.encoding]
 codec = "json"
-timestamp_format = "rfc3339"
+timestamp_format = "rfc3339"` + exceptFields + `
 {{end}}
 			`,
 	}
 }
 
+// TLSConf returns the TLS configuration of the sink preceded by the librdkafka options, which also
+// disable certificate verification for InsecureSkipVerify
 func TLSConf(id string, o logging.OutputSpec, secret *corev1.Secret, op Options, genTLSConf bool) []Element {
-	if o.Secret != nil {
-		conf := []Element{}
-
-		if tlsConf := common.GenerateTLSConfWithID(id, o, secret, op, genTLSConf); tlsConf != nil {
+	conf := []Element{}
+	options := NewLibrdkafka(id, o)
+	var tlsConf *common.TLSConf
+	if o.Secret != nil || o.TLS.HasReferences() {
+		if tlsConf = common.GenerateTLSConfWithID(id, o, secret, op, genTLSConf); tlsConf != nil {
 			// KafkaInsecure (InsecureTLS)
-			if o.TLS != nil && o.TLS.InsecureSkipVerify {
-				conf = append(conf, InsecureTLS{
-					ComponentID: id,
-				})
-			}
-
+			options.InsecureTLS = o.TLS != nil && o.TLS.InsecureSkipVerify
 			tlsConf.InsecureSkipVerify = false
-			conf = append(conf, tlsConf)
 		}
+	}
+	if !options.IsEmpty() {
+		conf = append(conf, options)
+	}
+	if tlsConf != nil {
 		// Kafka does not use the verify_certificate or verify_hostname options, see insecureTLS
-		return conf
+		conf = append(conf, tlsConf)
 	}
-	return []Element{}
+	return conf
 }
 
 func SASLConf(id string, o logging.OutputSpec, secret *corev1.Secret) []Element {
 	conf := []Element{}
-	// Try the preferred and deprecated names.
-	_, ok := common.TryKeys(secret, constants.SASLEnable, constants.DeprecatedSaslOverSSL)
-	if o.Secret != nil && ok {
+	if o.Secret != nil && o.Kafka != nil && o.Kafka.SASL != nil {
 		if common.HasUsernamePassword(secret) {
 			sasl := SASL{
 				Desc:        "SASL Config",
@@ -175,8 +239,7 @@ func SASLConf(id string, o logging.OutputSpec, secret *corev1.Secret) []Element
 				Password:    common.GetFromSecret(secret, constants.ClientPassword),
 				Mechanism:   SASLMechanismPlain,
 			}
-			if m := common.GetFromSecret(secret, constants.SASLMechanisms); m != "" {
-				// librdkafka does not support multiple values for sasl mechanism
+			if m := o.Kafka.SASL.Mechanism; m != "" {
 				sasl.Mechanism = m
 			}
 			conf = append(conf, sasl)
@@ -6,7 +6,9 @@ import (
 	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"testing"
 
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 	"github.com/openshift/cluster-logging-operator/test/helpers"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -33,6 +35,7 @@ var _ = Describe("Generate vector config", func() {
 						OutputTypeSpec: logging.OutputTypeSpec{
 							Kafka: &logging.Kafka{
 								Topic: "build_complete",
+								SASL:  &logging.KafkaSASL{},
 							},
 						},
 					},
@@ -41,9 +44,8 @@ var _ = Describe("Generate vector config", func() {
 			Secrets: map[string]*corev1.Secret{
 				"kafka-receiver": {
 					Data: map[string][]byte{
-						"sasl.enable": []byte("true"),
-						"username":    []byte("testuser"),
-						"password":    []byte("testpass"),
+						"username": []byte("testuser"),
+						"password": []byte("testpass"),
 					},
 				},
 			},
@@ -131,6 +133,7 @@ mechanism = "PLAIN"
 						OutputTypeSpec: logging.OutputTypeSpec{
 							Kafka: &logging.Kafka{
 								Topic: "build_complete",
+								SASL:  &logging.KafkaSASL{},
 							},
 						},
 					},
@@ -139,11 +142,10 @@ mechanism = "PLAIN"
 			Secrets: map[string]*corev1.Secret{
 				"kafka-receiver": {
 					Data: map[string][]byte{
-						"tls.key":     []byte("junk"),
-						"tls.crt":     []byte("junk"),
-						"sasl.enable": []byte("true"),
-						"username":    []byte("testuser"),
-						"password":    []byte("testpass"),
+						"tls.key":  []byte("junk"),
+						"tls.crt":  []byte("junk"),
+						"username": []byte("testuser"),
+						"password": []byte("testpass"),
 					},
 				},
 			},
@@ -236,6 +238,7 @@ mechanism = "PLAIN"
 						OutputTypeSpec: logging.OutputTypeSpec{
 							Kafka: &logging.Kafka{
 								Topic: "build_complete",
+								SASL:  &logging.KafkaSASL{Mechanism: logging.KafkaSASLMechanismScramSHA256},
 							},
 						},
 					},
@@ -244,12 +247,10 @@ mechanism = "PLAIN"
 			Secrets: map[string]*corev1.Secret{
 				"kafka-receiver": {
 					Data: map[string][]byte{
-						"tls.key":         []byte("junk"),
-						"tls.crt":         []byte("junk"),
-						"sasl.enable":     []byte("true"),
-						"sasl.mechanisms": []byte("SCRAM-SHA-256"),
-						"username":        []byte("testuser"),
-						"password":        []byte("testpass"),
+						"tls.key":  []byte("junk"),
+						"tls.crt":  []byte("junk"),
+						"username": []byte("testuser"),
+						"password": []byte("testpass"),
 					},
 				},
 			},
@@ -926,6 +927,277 @@ timestamp_format = "rfc3339"
 
 [sinks.kafka_receiver.buffer]
 when_full = "drop_newest"
+`,
+		}),
+		Entry("with topic and key templates", helpers.ConfGenerateTest{
+			CLFSpec: logging.ClusterLogForwarderSpec{
+				Outputs: []logging.OutputSpec{
+					{
+						Type: logging.OutputTypeKafka,
+						Name: "kafka-receiver",
+						URL:  "tcp://broker1-kafka.svc.messaging.cluster.local:9092",
+						OutputTypeSpec: logging.OutputTypeSpec{
+							Kafka: &logging.Kafka{
+								Topic: `app-{.kubernetes.namespace_name||"none"}`,
+								Key:   `{.kubernetes.pod_name||"none"}`,
+							},
+						},
+					},
+				},
+			},
+			Secrets: security.NoSecrets,
+			ExpectedConf: `
+[transforms.kafka_receiver_dedot]
+type = "lua"
+inputs = ["pipeline_1","pipeline_2"]
+version = "2"
+hooks.init = "init"
+hooks.process = "process"
+source = '''
+    function init()
+        count = 0
+    end
+    function process(event, emit)
+        count = count + 1
+        event.log.openshift.sequence = count
+        if event.log.kubernetes == nil then
+            emit(event)
+            return
+        end
+        if event.log.kubernetes.labels == nil then
+            emit(event)
+            return
+        end
+		dedot(event.log.kubernetes.namespace_labels)
+        dedot(event.log.kubernetes.labels)
+        emit(event)
+    end
+
+    function dedot(map)
+        if map == nil then
+            return
+        end
+        local new_map = {}
+        local changed_keys = {}
+        for k, v in pairs(map) do
+            local dedotted = string.gsub(k, "[./]", "_")
+            if dedotted ~= k then
+                new_map[dedotted] = v
+                changed_keys[k] = true
+            end
+        end
+        for k in pairs(changed_keys) do
+            map[k] = nil
+        end
+        for k, v in pairs(new_map) do
+            map[k] = v
+        end
+    end
+'''
+
+# Kafka Topic and Key
+[transforms.kafka_receiver_topic]
+type = "remap"
+inputs = ["kafka_receiver_dedot"]
+source = '''
+  .kafka_topic = "app-" + (string(.kubernetes.namespace_name) ?? "none")
+  .kafka_key = (string(.kubernetes.pod_name) ?? "none")
+'''
+
+# Kafka config
+[sinks.kafka_receiver]
+type = "kafka"
+inputs = ["kafka_receiver_topic"]
+bootstrap_servers = "broker1-kafka.svc.messaging.cluster.local:9092"
+topic = "{{ kafka_topic }}"
+key_field = "kafka_key"
+
+[sinks.kafka_receiver.encoding]
+codec = "json"
+timestamp_format = "rfc3339"
+except_fields = ["kafka_topic","kafka_key"]
+
+[sinks.kafka_receiver.buffer]
+when_full = "drop_newest"
+`,
+		}),
+		Entry("with producer options", helpers.ConfGenerateTest{
+			CLFSpec: logging.ClusterLogForwarderSpec{
+				Outputs: []logging.OutputSpec{
+					{
+						Type: logging.OutputTypeKafka,
+						Name: "kafka-receiver",
+						URL:  "tcp://broker1-kafka.svc.messaging.cluster.local:9092/topic",
+						OutputTypeSpec: logging.OutputTypeSpec{
+							Kafka: &logging.Kafka{
+								RequiredAcks:    utils.GetPtr(int32(-1)),
+								Compression:     "lz4",
+								MaxMessageBytes: utils.GetPtr(int64(2000000)),
+								Idempotent:      true,
+							},
+						},
+					},
+				},
+			},
+			Secrets: security.NoSecrets,
+			ExpectedConf: `
+[transforms.kafka_receiver_dedot]
+type = "lua"
+inputs = ["pipeline_1","pipeline_2"]
+version = "2"
+hooks.init = "init"
+hooks.process = "process"
+source = '''
+    function init()
+        count = 0
+    end
+    function process(event, emit)
+        count = count + 1
+        event.log.openshift.sequence = count
+        if event.log.kubernetes == nil then
+            emit(event)
+            return
+        end
+        if event.log.kubernetes.labels == nil then
+            emit(event)
+            return
+        end
+		dedot(event.log.kubernetes.namespace_labels)
+        dedot(event.log.kubernetes.labels)
+        emit(event)
+    end
+
+    function dedot(map)
+        if map == nil then
+            return
+        end
+        local new_map = {}
+        local changed_keys = {}
+        for k, v in pairs(map) do
+            local dedotted = string.gsub(k, "[./]", "_")
+            if dedotted ~= k then
+                new_map[dedotted] = v
+                changed_keys[k] = true
+            end
+        end
+        for k in pairs(changed_keys) do
+            map[k] = nil
+        end
+        for k, v in pairs(new_map) do
+            map[k] = v
+        end
+    end
+'''
+
+# Kafka config
+[sinks.kafka_receiver]
+type = "kafka"
+inputs = ["kafka_receiver_dedot"]
+bootstrap_servers = "broker1-kafka.svc.messaging.cluster.local:9092"
+topic = "topic"
+compression = "lz4"
+
+[sinks.kafka_receiver.encoding]
+codec = "json"
+timestamp_format = "rfc3339"
+
+[sinks.kafka_receiver.buffer]
+when_full = "drop_newest"
+
+[sinks.kafka_receiver.librdkafka_options]
+"request.required.acks" = "-1"
+"message.max.bytes" = "2000000"
+"enable.idempotence" = "true"
+`,
+		}),
+		Entry("with tuning", helpers.ConfGenerateTest{
+			CLFSpec: logging.ClusterLogForwarderSpec{
+				Outputs: []logging.OutputSpec{
+					{
+						Type: logging.OutputTypeKafka,
+						Name: "kafka-receiver",
+						URL:  "tcp://broker1-kafka.svc.messaging.cluster.local:9092/topic",
+						Tuning: &logging.OutputTuningSpec{
+							MaxBatchEvents: utils.GetPtr(int64(1000)),
+							Compression:    "zstd",
+							Buffer: &logging.OutputBufferSpec{
+								Type:     logging.BufferTypeDisk,
+								MaxSize:  utils.GetPtr(resource.MustParse("1Gi")),
+								WhenFull: logging.BufferWhenFullBlock,
+							},
+						},
+					},
+				},
+			},
+			Secrets: security.NoSecrets,
+			ExpectedConf: `
+[transforms.kafka_receiver_dedot]
+type = "lua"
+inputs = ["pipeline_1","pipeline_2"]
+version = "2"
+hooks.init = "init"
+hooks.process = "process"
+source = '''
+    function init()
+        count = 0
+    end
+    function process(event, emit)
+        count = count + 1
+        event.log.openshift.sequence = count
+        if event.log.kubernetes == nil then
+            emit(event)
+            return
+        end
+        if event.log.kubernetes.labels == nil then
+            emit(event)
+            return
+        end
+		dedot(event.log.kubernetes.namespace_labels)
+        dedot(event.log.kubernetes.labels)
+        emit(event)
+    end
+
+    function dedot(map)
+        if map == nil then
+            return
+        end
+        local new_map = {}
+        local changed_keys = {}
+        for k, v in pairs(map) do
+            local dedotted = string.gsub(k, "[./]", "_")
+            if dedotted ~= k then
+                new_map[dedotted] = v
+                changed_keys[k] = true
+            end
+        end
+        for k in pairs(changed_keys) do
+            map[k] = nil
+        end
+        for k, v in pairs(new_map) do
+            map[k] = v
+        end
+    end
+'''
+
+# Kafka config
+[sinks.kafka_receiver]
+type = "kafka"
+inputs = ["kafka_receiver_dedot"]
+bootstrap_servers = "broker1-kafka.svc.messaging.cluster.local:9092"
+topic = "topic"
+compression = "zstd"
+
+[sinks.kafka_receiver.encoding]
+codec = "json"
+timestamp_format = "rfc3339"
+
+[sinks.kafka_receiver.buffer]
+type = "disk"
+max_size = 1073741824
+when_full = "block"
+
+[sinks.kafka_receiver.batch]
+max_events = 1000
 `,
 		}),
 	)
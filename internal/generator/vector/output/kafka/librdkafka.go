@@ -0,0 +1,58 @@
+package kafka
+
+import (
+	"fmt"
+
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+)
+
+// Librdkafka are the options of the sink passed to the librdkafka client
+type Librdkafka struct {
+	ComponentID     string
+	InsecureTLS     bool
+	RequiredAcks    string
+	MaxMessageBytes string
+	Idempotent      bool
+}
+
+func NewLibrdkafka(id string, o logging.OutputSpec) Librdkafka {
+	options := Librdkafka{
+		ComponentID: id,
+	}
+	if o.Kafka != nil {
+		if o.Kafka.RequiredAcks != nil {
+			options.RequiredAcks = fmt.Sprintf("%d", *o.Kafka.RequiredAcks)
+		}
+		if o.Kafka.MaxMessageBytes != nil {
+			options.MaxMessageBytes = fmt.Sprintf("%d", *o.Kafka.MaxMessageBytes)
+		}
+		options.Idempotent = o.Kafka.Idempotent
+	}
+	return options
+}
+
+func (l Librdkafka) IsEmpty() bool {
+	return !l.InsecureTLS && l.RequiredAcks == "" && l.MaxMessageBytes == "" && !l.Idempotent
+}
+
+func (l Librdkafka) Name() string {
+	return "kafkaLibrdkafkaTemplate"
+}
+
+func (l Librdkafka) Template() string {
+	return `{{define "` + l.Name() + `" -}}
+[sinks.{{.ComponentID}}.librdkafka_options]
+{{- if .InsecureTLS }}
+"enable.ssl.certificate.verification" = "false"
+{{- end }}
+{{- if .RequiredAcks }}
+"request.required.acks" = "{{.RequiredAcks}}"
+{{- end }}
+{{- if .MaxMessageBytes }}
+"message.max.bytes" = "{{.MaxMessageBytes}}"
+{{- end }}
+{{- if .Idempotent }}
+"enable.idempotence" = "true"
+{{- end }}
+{{- end}}`
+}
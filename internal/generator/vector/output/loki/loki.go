@@ -49,6 +49,7 @@ type Loki struct {
 	TenantID    Element
 	Endpoint    string
 	LokiLabel   []string
+	Compression string
 }
 
 func (l Loki) Name() string {
@@ -63,13 +64,17 @@ inputs = {{.Inputs}}
 endpoint = "{{.Endpoint}}"
 out_of_order_action = "accept"
 healthcheck.enabled = false
+{{- if .Compression }}
+compression = "{{.Compression}}"
+{{- end }}
 {{kv .TenantID -}}
 {{end}}`
 }
 
 type LokiEncoding struct {
-	ComponentID string
-	Codec       string
+	ComponentID  string
+	Codec        string
+	ExceptFields []string
 }
 
 func (le LokiEncoding) Name() string {
@@ -80,6 +85,9 @@ func (le LokiEncoding) Template() string {
 	return `{{define "` + le.Name() + `" -}}
 [sinks.{{.ComponentID}}.encoding]
 codec = {{.Codec}}
+{{- with .ExceptFields }}
+except_fields = [{{range $i, $f := .}}{{if $i}},{{end}}"{{$f}}"{{end}}]
+{{- end }}
 {{end}}`
 }
 
@@ -115,19 +123,27 @@ func New(id string, o logging.OutputSpec, inputs []string, secret *corev1.Secret
 	}
 	componentID := vectorhelpers.MakeID(id, "remap")
 	dedottedID := vectorhelpers.MakeID(id, "dedot")
+	elements := []Element{
+		CleanupFields(componentID, inputs),
+	}
+	if hasPodLabelKeys(o) {
+		streamLabelsID := vectorhelpers.MakeID(id, "stream_labels")
+		elements = append(elements, StreamLabels(streamLabelsID, o, []string{componentID}))
+		componentID = streamLabelsID
+	}
 	return MergeElements(
+		elements,
 		[]Element{
-			CleanupFields(componentID, inputs),
 			normalize.DedotLabels(dedottedID, []string{componentID}),
 			Output(id, o, []string{dedottedID}),
 			Encoding(id, o),
-			common.NewBuffer(id),
-			common.NewRequest(id),
+			common.NewBuffer(id, o),
+			common.NewRequest(id, o),
 			Labels(id, o),
 		},
+		common.NewBatch(id, o),
 		TLSConf(id, o, secret, op),
-		BasicAuth(id, o, secret),
-		BearerTokenAuth(id, o, secret),
+		Auth(id, o, secret),
 	)
 }
 
@@ -137,14 +153,19 @@ func Output(id string, o logging.OutputSpec, inputs []string) Element {
 		Inputs:      vectorhelpers.MakeInputs(inputs...),
 		Endpoint:    o.URL,
 		TenantID:    Tenant(o.Loki),
+		Compression: common.Compression(o, ""),
 	}
 }
 
 func Encoding(id string, o logging.OutputSpec) Element {
-	return LokiEncoding{
+	encoding := LokiEncoding{
 		ComponentID: id,
 		Codec:       lokiEncodingJson,
 	}
+	if hasPodLabelKeys(o) {
+		encoding.ExceptFields = []string{StreamLabelsField}
+	}
+	return encoding
 }
 
 func lokiLabelKeys(l *logging.Loki) []string {
@@ -162,10 +183,8 @@ func lokiLabelKeys(l *logging.Loki) []string {
 func lokiLabels(lo *logging.Loki) []Label {
 	ls := []Label{}
 	for _, k := range lokiLabelKeys(lo) {
-		r := strings.NewReplacer(".", "_", "/", "_", "\\", "_", "-", "_")
-		name := r.Replace(k)
 		l := Label{
-			Name:  name,
+			Name:  LabelName(k),
 			Value: formatLokiLabelValue(k),
 		}
 		if k == lokiLabelKubernetesHost {
@@ -179,6 +198,12 @@ func lokiLabels(lo *logging.Loki) []Label {
 	return ls
 }
 
+// LabelName returns the name of the Loki label for a record key
+func LabelName(key string) string {
+	r := strings.NewReplacer(".", "_", "/", "_", "\\", "_", "-", "_")
+	return r.Replace(key)
+}
+
 func formatLokiLabelValue(value string) string {
 	if strings.HasPrefix(value, "kubernetes.labels.") || strings.HasPrefix(value, "kubernetes.namespace_labels.") {
 		parts := strings.SplitAfterN(value, "labels.", 2)
@@ -191,9 +216,13 @@ func formatLokiLabelValue(value string) string {
 }
 
 func Labels(id string, o logging.OutputSpec) Element {
+	labels := lokiLabels(o.Loki)
+	if hasPodLabelKeys(o) {
+		labels = append(labels, streamLabel())
+	}
 	return LokiLabels{
 		ComponentID: id,
-		Labels:      lokiLabels(o.Loki),
+		Labels:      labels,
 	}
 }
 
@@ -206,7 +235,7 @@ func Tenant(l *logging.Loki) Element {
 
 func TLSConf(id string, o logging.OutputSpec, secret *corev1.Secret, op Options) []Element {
 	conf := []Element{}
-	if isDefaultOutput(o.Name) {
+	if isDefaultOutput(o.Name) || o.Type == logging.OutputTypeLokiStack {
 		// Set CA from logcollector ServiceAccount for internal Loki
 		tlsConf := common.TLSConf{
 			ComponentID: id,
@@ -215,7 +244,7 @@ func TLSConf(id string, o logging.OutputSpec, secret *corev1.Secret, op Options)
 		tlsConf.SetTLSProfileFromOptions(op)
 		return append(conf, tlsConf)
 	}
-	if o.Secret != nil || (o.TLS != nil && o.TLS.InsecureSkipVerify) {
+	if o.Secret != nil || (o.TLS != nil && o.TLS.InsecureSkipVerify) || o.TLS.HasReferences() {
 
 		if tlsConf := common.GenerateTLSConfWithID(id, o, secret, op, false); tlsConf != nil {
 			tlsConf.NeedsEnabled = false
@@ -239,6 +268,17 @@ func isDefaultOutput(name string) bool {
 	return strings.HasPrefix(name, "default-")
 }
 
+// Auth is the service account token authentication when configured, else basic or bearer token authentication
+func Auth(id string, o logging.OutputSpec, secret *corev1.Secret) []Element {
+	if o.ServiceAccountToken != nil {
+		return common.ServiceAccountTokenAuth(id, o)
+	}
+	return MergeElements(
+		BasicAuth(id, o, secret),
+		BearerTokenAuth(id, o, secret),
+	)
+}
+
 func BasicAuth(id string, o logging.OutputSpec, secret *corev1.Secret) []Element {
 	conf := []Element{}
 
@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
 )
 
 var _ = Describe("Loki generator helpers", func() {
@@ -35,4 +36,142 @@ var _ = Describe("Loki generator helpers", func() {
 		Entry(" for complex label", `kubernetes.labels.foo-bar-xyz.abc/bar`, "kubernetes_labels_foo_bar_xyz_abc_bar", `{{kubernetes.labels.\"foo-bar-xyz_abc_bar\"}}`),
 	)
 
+	DescribeTable("#luaPattern should translate globs", func(glob, exp string) {
+		Expect(luaPattern(glob)).To(Equal(exp))
+	},
+		Entry(" for a plain key", "team", "^team$"),
+		Entry(" for a prefixed key", "app.kubernetes.io/*", "^app%.kubernetes%.io/.*$"),
+		Entry(" for a key with dashes", "*-tier", "^.*%-tier$"),
+	)
+
+	DescribeTable("#MatchesPodLabelGlob", func(glob, key string, exp bool) {
+		Expect(MatchesPodLabelGlob(glob, key)).To(Equal(exp))
+	},
+		Entry(" for a matching prefix", "app.kubernetes.io/*", "app.kubernetes.io/name", true),
+		Entry(" for a literal dot", "app.kubernetes.io/*", "appXkubernetes.io/name", false),
+		Entry(" for the match all glob", "*", "pod-template-hash", true),
+		Entry(" for a different key", "team", "pod-template-hash", false),
+	)
+
+	Context("with pod label keys", func() {
+		output := logging.OutputSpec{
+			Name: "loki",
+			Type: logging.OutputTypeLoki,
+			URL:  "https://loki.example.com",
+			OutputTypeSpec: logging.OutputTypeSpec{
+				Loki: &logging.Loki{
+					LabelKeys:      []string{"log_type"},
+					PodLabelKeys:   []string{"app.kubernetes.io/*", "team"},
+					MaxLabelValues: 10,
+				},
+			},
+		}
+		It("should select the pod labels with a cardinality limit", func() {
+			conf := StreamLabels("loki_stream_labels", output, []string{"loki_remap"})
+			Expect(`
+[transforms.loki_stream_labels]
+type = "lua"
+inputs = ["loki_remap"]
+version = "2"
+hooks.init = "init"
+hooks.process = "process"
+source = '''
+    patterns = {"^app%.kubernetes%.io/.*$", "^team$"}
+    max_values = 10
+    function init()
+        seen = {}
+    end
+    function process(event, emit)
+        if event.log.kubernetes == nil or event.log.kubernetes.labels == nil then
+            emit(event)
+            return
+        end
+        local stream = nil
+        local sources = {}
+        for key, value in pairs(event.log.kubernetes.labels) do
+            if matches(key) then
+                local name = string.gsub(key, "[^a-zA-Z0-9_]", "_")
+                if sources[name] == nil or key < sources[name] then
+                    sources[name] = key
+                    stream = stream or {}
+                    stream[name] = tostring(value)
+                end
+            end
+        end
+        if stream ~= nil then
+            for name, value in pairs(stream) do
+                stream[name] = limit(name, value)
+            end
+        end
+        event.log.loki_stream_labels = stream
+        emit(event)
+    end
+    function matches(key)
+        for _, pattern in ipairs(patterns) do
+            if string.find(key, pattern) then
+                return true
+            end
+        end
+        return false
+    end
+    function limit(name, value)
+        local values = seen[name]
+        if values == nil then
+            values = {count = 0, set = {}}
+            seen[name] = values
+        end
+        if values.set[value] then
+            return value
+        end
+        if values.count >= max_values then
+            return "__overflow__"
+        end
+        values.count = values.count + 1
+        values.set[value] = true
+        return value
+    end
+'''
+`).To(EqualConfigFrom(conf))
+		})
+		It("should expand the selected pod labels into Loki labels", func() {
+			Expect(`
+[sinks.loki.labels]
+kubernetes_host = "${VECTOR_SELF_NODE_NAME}"
+log_type = "{{log_type}}"
+"kubernetes_labels_*" = "{{loki_stream_labels}}"
+`).To(EqualConfigFrom(Labels("loki", output)))
+		})
+		It("should not encode the selected pod labels", func() {
+			Expect(`
+[sinks.loki.encoding]
+codec = "json"
+except_fields = ["loki_stream_labels"]
+`).To(EqualConfigFrom(Encoding("loki", output)))
+		})
+	})
+
+	Context("with a service account token", func() {
+		output := logging.OutputSpec{
+			Type: logging.OutputTypeLoki,
+			Name: "loki",
+			URL:  "https://loki.example.com",
+			ServiceAccountToken: &logging.ServiceAccountToken{
+				Audience: "loki",
+			},
+		}
+		It("should send the projected token as bearer token", func() {
+			Expect(`
+[secret.loki_sa_token]
+type = "directory"
+path = "/var/run/ocp-collector/serviceaccount/sa-token-loki"
+remove_trailing_whitespace = true
+
+# Bearer Auth Config
+[sinks.loki.auth]
+strategy = "bearer"
+token = "SECRET[loki_sa_token.token]"
+`).To(EqualConfigFrom(Auth("loki", output, nil)))
+		})
+	})
+
 })
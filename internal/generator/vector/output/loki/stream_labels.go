@@ -0,0 +1,174 @@
+package loki
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	// StreamLabelsField is the record field holding the pod labels selected by PodLabelKeys
+	StreamLabelsField = "loki_stream_labels"
+
+	// DefaultMaxLabelValues is the default number of distinct values of a pod label added as Loki label
+	DefaultMaxLabelValues = 100
+
+	// OverflowLabelValue replaces the values of a pod label beyond MaxLabelValues
+	OverflowLabelValue = "__overflow__"
+
+	podLabelPrefix = "kubernetes_labels_"
+)
+
+var (
+	// UnboundedLabelKeys are record keys that have a value per pod, container or record
+	UnboundedLabelKeys = []string{
+		"kubernetes.pod_name",
+		"kubernetes.pod_id",
+		"kubernetes.pod_ip",
+		"kubernetes.container_id",
+		"docker.container_id",
+		"openshift.sequence",
+		"viaq_msg_id",
+		"message",
+		"@timestamp",
+	}
+	// UnboundedPodLabels are pod labels set by controllers that have a value per pod, revision or job
+	UnboundedPodLabels = []string{
+		"pod-template-hash",
+		"controller-revision-hash",
+		"statefulset.kubernetes.io/pod-name",
+		"apps.kubernetes.io/pod-index",
+		"controller-uid",
+		"job-name",
+		"batch.kubernetes.io/controller-uid",
+		"batch.kubernetes.io/job-name",
+	}
+
+	podLabelGlobRegex = regexp.MustCompile(`^[a-zA-Z0-9._/*-]+$`)
+	luaMagicRegex     = regexp.MustCompile(`[\^$()%.\[\]*+\-?]`)
+)
+
+// VerifyPodLabelGlob verifies a glob only contains characters allowed in label keys and the '*' wildcard
+func VerifyPodLabelGlob(glob string) error {
+	if !podLabelGlobRegex.MatchString(glob) {
+		return fmt.Errorf("invalid pod label key glob %q", glob)
+	}
+	return nil
+}
+
+// MatchesPodLabelGlob returns true if the pod label key matches the glob
+func MatchesPodLabelGlob(glob, key string) bool {
+	parts := strings.Split(glob, "*")
+	for i, p := range parts {
+		parts[i] = regexp.QuoteMeta(p)
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$").MatchString(key)
+}
+
+// luaPattern translates a glob into an anchored Lua pattern
+func luaPattern(glob string) string {
+	parts := strings.Split(glob, "*")
+	for i, p := range parts {
+		parts[i] = luaMagicRegex.ReplaceAllString(p, "%$0")
+	}
+	return "^" + strings.Join(parts, ".*") + "$"
+}
+
+func hasPodLabelKeys(o logging.OutputSpec) bool {
+	return o.Loki != nil && len(o.Loki.PodLabelKeys) > 0
+}
+
+// StreamLabels selects the pod labels matching PodLabelKeys into StreamLabelsField. Label names are sanitized, pod
+// labels with the same sanitized name are resolved to the smallest key. Each label is limited to MaxLabelValues
+// distinct values per collector, further values are replaced by OverflowLabelValue
+func StreamLabels(id string, o logging.OutputSpec, inputs []string) Element {
+	patterns := make([]string, len(o.Loki.PodLabelKeys))
+	for i, glob := range o.Loki.PodLabelKeys {
+		patterns[i] = fmt.Sprintf("%q", luaPattern(glob))
+	}
+	maxValues := o.Loki.MaxLabelValues
+	if maxValues <= 0 {
+		maxValues = DefaultMaxLabelValues
+	}
+	return ConfLiteral{
+		ComponentID:  id,
+		InLabel:      vectorhelpers.MakeInputs(inputs...),
+		TemplateName: "lokiStreamLabels",
+		TemplateStr: `{{define "lokiStreamLabels" -}}
+[transforms.{{.ComponentID}}]
+type = "lua"
+inputs = {{.InLabel}}
+version = "2"
+hooks.init = "init"
+hooks.process = "process"
+source = '''
+    patterns = {` + strings.Join(patterns, ", ") + `}
+    max_values = ` + fmt.Sprint(maxValues) + `
+    function init()
+        seen = {}
+    end
+    function process(event, emit)
+        if event.log.kubernetes == nil or event.log.kubernetes.labels == nil then
+            emit(event)
+            return
+        end
+        local stream = nil
+        local sources = {}
+        for key, value in pairs(event.log.kubernetes.labels) do
+            if matches(key) then
+                local name = string.gsub(key, "[^a-zA-Z0-9_]", "_")
+                if sources[name] == nil or key < sources[name] then
+                    sources[name] = key
+                    stream = stream or {}
+                    stream[name] = tostring(value)
+                end
+            end
+        end
+        if stream ~= nil then
+            for name, value in pairs(stream) do
+                stream[name] = limit(name, value)
+            end
+        end
+        event.log.` + StreamLabelsField + ` = stream
+        emit(event)
+    end
+    function matches(key)
+        for _, pattern in ipairs(patterns) do
+            if string.find(key, pattern) then
+                return true
+            end
+        end
+        return false
+    end
+    function limit(name, value)
+        local values = seen[name]
+        if values == nil then
+            values = {count = 0, set = {}}
+            seen[name] = values
+        end
+        if values.set[value] then
+            return value
+        end
+        if values.count >= max_values then
+            return "` + OverflowLabelValue + `"
+        end
+        values.count = values.count + 1
+        values.set[value] = true
+        return value
+    end
+'''
+{{end}}`,
+	}
+}
+
+// streamLabel is the Loki label expanding StreamLabelsField into one label per pod label
+func streamLabel() Label {
+	return Label{
+		Name:  fmt.Sprintf("%q", podLabelPrefix+"*"),
+		Value: fmt.Sprintf("{{%s}}", StreamLabelsField),
+	}
+}
@@ -0,0 +1,53 @@
+package lokistack
+
+import (
+	"fmt"
+
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/loki"
+	logstore "github.com/openshift/cluster-logging-operator/internal/logstore/lokistack"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Tenants are the LokiStack tenants, named by the log type they receive
+var Tenants = []string{
+	logging.InputNameApplication,
+	logging.InputNameInfrastructure,
+	logging.InputNameAudit,
+}
+
+// New routes records by log type to a Loki sink for the matching tenant of the LokiStack gateway.
+// Records of custom log types are routed to the application tenant
+func New(id string, o logging.OutputSpec, inputs []string, secret *corev1.Secret, op Options) []Element {
+	routeID := vectorhelpers.MakeID(id, "route")
+	route := Route{
+		ComponentID: routeID,
+		Inputs:      vectorhelpers.MakeInputs(inputs...),
+		Routes:      map[string]string{},
+	}
+	els := []Element{route}
+	for _, tenant := range Tenants {
+		route.Routes[tenant] = fmt.Sprintf("'.log_type == %q'", tenant)
+		if tenant == logging.InputNameApplication {
+			route.Routes[tenant] = fmt.Sprintf(`'!includes([%q, %q], .log_type)'`, logging.InputNameInfrastructure, logging.InputNameAudit)
+		}
+		els = append(els, loki.New(vectorhelpers.MakeID(id, tenant), TenantOutput(o, tenant), []string{routeID + "." + tenant}, secret, op)...)
+	}
+	return els
+}
+
+// TenantOutput is the spec of the loki output for a tenant of the LokiStack, authenticated with the token of the
+// secret or a projected service account token
+func TenantOutput(o logging.OutputSpec, tenant string) logging.OutputSpec {
+	ls := o.LokiStack
+	if ls == nil {
+		ls = &logging.LokiStack{}
+	}
+	o.URL = logstore.TenantURL(logstore.GatewayServiceName(ls.Name), ls.Namespace, tenant)
+	o.Loki = nil
+	o.ServiceAccountToken = o.GetServiceAccountToken()
+	return o
+}
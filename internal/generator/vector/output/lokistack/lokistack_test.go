@@ -0,0 +1,94 @@
+package lokistack
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/loki"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Generating vector config for LokiStack output", func() {
+	var (
+		output logging.OutputSpec
+		secret *corev1.Secret
+	)
+	BeforeEach(func() {
+		output = logging.OutputSpec{
+			Name: "lokistack",
+			Type: logging.OutputTypeLokiStack,
+			OutputTypeSpec: logging.OutputTypeSpec{
+				LokiStack: &logging.LokiStack{
+					Name:      "logging-loki",
+					Namespace: "loki",
+				},
+			},
+			Secret: &logging.OutputSecretSpec{Name: "lokistack-token"},
+		}
+		secret = &corev1.Secret{Data: map[string][]byte{"token": []byte("sa-token")}}
+	})
+
+	It("should route records by log type", func() {
+		conf := New("lokistack", output, []string{"pipeline_a"}, secret, framework.Options{})
+		Expect(`
+[transforms.lokistack_route]
+type = "route"
+inputs = ["pipeline_a"]
+route.application = '!includes(["infrastructure", "audit"], .log_type)'
+route.audit = '.log_type == "audit"'
+route.infrastructure = '.log_type == "infrastructure"'
+`).To(EqualConfigFrom(conf[0]))
+	})
+
+	DescribeTable("should forward each log type to the tenant of the gateway", func(tenant string) {
+		conf := New("lokistack", output, []string{"pipeline_a"}, secret, framework.Options{})
+		tenantID := "lokistack_" + tenant
+		Expect(conf).To(ContainElement(loki.Output(tenantID, TenantOutput(output, tenant), []string{tenantID + "_dedot"})))
+		Expect(conf).To(ContainElement(loki.CleanupFields(tenantID+"_remap", []string{"lokistack_route." + tenant})))
+	},
+		Entry("for application logs", logging.InputNameApplication),
+		Entry("for infrastructure logs", logging.InputNameInfrastructure),
+		Entry("for audit logs", logging.InputNameAudit),
+	)
+
+	It("should derive the tenant URL from the LokiStack", func() {
+		Expect(TenantOutput(output, logging.InputNameAudit).URL).To(Equal("https://logging-loki-gateway-http.loki.svc:8080/api/logs/v1/audit"))
+	})
+
+	It("should verify the gateway with the service CA and authenticate with the token", func() {
+		tenantOutput := TenantOutput(output, logging.InputNameApplication)
+		Expect(`
+[sinks.lokistack_application.tls]
+ca_file = "/var/run/secrets/kubernetes.io/serviceaccount/service-ca.crt"
+
+# Bearer Auth Config
+[sinks.lokistack_application.auth]
+strategy = "bearer"
+token = "sa-token"
+`).To(EqualConfigFrom(append(loki.TLSConf("lokistack_application", tenantOutput, secret, framework.Options{}),
+			loki.BearerTokenAuth("lokistack_application", tenantOutput, secret)...)))
+	})
+
+	It("should authenticate with a projected service account token without a secret", func() {
+		output.Secret = nil
+		tenantOutput := TenantOutput(output, logging.InputNameApplication)
+		Expect(`
+[sinks.lokistack_application.tls]
+ca_file = "/var/run/secrets/kubernetes.io/serviceaccount/service-ca.crt"
+
+[secret.lokistack_application_sa_token]
+type = "directory"
+path = "/var/run/ocp-collector/serviceaccount/sa-token-lokistack"
+remove_trailing_whitespace = true
+
+# Bearer Auth Config
+[sinks.lokistack_application.auth]
+strategy = "bearer"
+token = "SECRET[lokistack_application_sa_token.token]"
+`).To(EqualConfigFrom(append(loki.TLSConf("lokistack_application", tenantOutput, nil, framework.Options{}),
+			loki.Auth("lokistack_application", tenantOutput, nil)...)))
+	})
+})
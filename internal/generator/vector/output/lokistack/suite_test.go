@@ -0,0 +1,13 @@
+package lokistack
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestLokiStack(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[generator][vector][output][lokistack] Unit Tests")
+}
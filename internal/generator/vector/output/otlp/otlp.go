@@ -0,0 +1,231 @@
+package otlp
+
+import (
+	"net/url"
+	"strings"
+
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	genhelper "github.com/openshift/cluster-logging-operator/internal/generator/helpers"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/http"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// LogsPath is the OTLP/HTTP path for logs, used when the output URL has no path
+	LogsPath = "/v1/logs"
+
+	// DefaultMaxBatchEvents is the default number of records merged into one request
+	DefaultMaxBatchEvents = 100
+	// DefaultBatchTimeoutSecs is the default time to wait for more records before a request is sent
+	DefaultBatchTimeoutSecs = 1
+)
+
+type OTLP struct {
+	ComponentID string
+	Inputs      string
+	URI         string
+	Compression string
+}
+
+func (o OTLP) Name() string {
+	return "vectorOTLPTemplate"
+}
+
+// Template for an http sink posting one OTLP/JSON request per event.
+// An OTLP/JSON request is a single JSON object, it can not be a batch of records framed as an array,
+// records are batched into one request by the Reduce transform instead
+func (o OTLP) Template() string {
+	return `{{define "` + o.Name() + `" -}}
+[sinks.{{.ComponentID}}]
+type = "http"
+inputs = {{.Inputs}}
+uri = "{{.URI}}"
+method = "post"
+{{- if .Compression }}
+compression = "{{.Compression}}"
+{{- end }}
+
+[sinks.{{.ComponentID}}.encoding]
+codec = "json"
+
+[sinks.{{.ComponentID}}.framing]
+method = "newline_delimited"
+
+[sinks.{{.ComponentID}}.batch]
+max_events = 1
+{{end}}`
+}
+
+// Reduce batches OTLP/JSON requests of single records into one request by concatenating their resourceLogs
+type Reduce struct {
+	ComponentID   string
+	Inputs        string
+	MaxEvents     int64
+	ExpireAfterMs int64
+}
+
+func (r Reduce) Name() string {
+	return "vectorOTLPReduceTemplate"
+}
+
+func (r Reduce) Template() string {
+	return `{{define "` + r.Name() + `" -}}
+[transforms.{{.ComponentID}}]
+type = "reduce"
+inputs = {{.Inputs}}
+max_events = {{.MaxEvents}}
+expire_after_ms = {{.ExpireAfterMs}}
+merge_strategies.resourceLogs = "concat"
+{{end}}`
+}
+
+// Batch merges up to maxBatchEvents records into one request, sent once no record arrived for batchTimeoutSecs
+func Batch(id string, o logging.OutputSpec, inputs []string) Element {
+	r := Reduce{
+		ComponentID:   id,
+		Inputs:        vectorhelpers.MakeInputs(inputs...),
+		MaxEvents:     DefaultMaxBatchEvents,
+		ExpireAfterMs: DefaultBatchTimeoutSecs * 1000,
+	}
+	if t := o.Tuning; t != nil {
+		if t.MaxBatchEvents != nil {
+			r.MaxEvents = *t.MaxBatchEvents
+		}
+		if t.BatchTimeoutSecs != nil {
+			r.ExpireAfterMs = *t.BatchTimeoutSecs * 1000
+		}
+	}
+	return r
+}
+
+// Transform converts ViaQ records of any log type into OTLP/JSON logs requests
+func Transform(id string, inputs []string) Element {
+	return Remap{
+		Desc:        "Convert log records to OTLP/JSON",
+		ComponentID: id,
+		Inputs:      vectorhelpers.MakeInputs(inputs...),
+		VRL: strings.TrimSpace(`
+resource = []
+for_each(compact({
+  "k8s.namespace.name": .kubernetes.namespace_name,
+  "k8s.namespace.uid": .kubernetes.namespace_id,
+  "k8s.pod.name": .kubernetes.pod_name,
+  "k8s.pod.uid": .kubernetes.pod_id,
+  "k8s.pod.ip": .kubernetes.pod_ip,
+  "k8s.container.name": .kubernetes.container_name,
+  "container.id": .kubernetes.container_id,
+  "container.image.name": .kubernetes.container_image,
+  "host.name": .hostname,
+  "openshift.cluster.uid": .openshift.cluster_id
+})) -> |key, value| {
+  resource = push(resource, {"key": key, "value": {"stringValue": to_string(value) ?? ""}})
+}
+attributes = []
+for_each(compact({
+  "log.type": .log_type,
+  "log.file.path": .file
+})) -> |key, value| {
+  attributes = push(attributes, {"key": key, "value": {"stringValue": to_string(value) ?? ""}})
+}
+ts = timestamp(."@timestamp") ?? parse_timestamp(string(."@timestamp") ?? "", "%+") ?? now()
+level = to_string(.level) ?? "default"
+severity = 0
+if includes(["trace"], level) {
+  severity = 1
+} else if includes(["debug"], level) {
+  severity = 5
+} else if includes(["info", "notice"], level) {
+  severity = 9
+} else if includes(["warn", "warning"], level) {
+  severity = 13
+} else if includes(["err", "error"], level) {
+  severity = 17
+} else if includes(["crit", "critical", "alert", "emerg", "emergency", "fatal"], level) {
+  severity = 21
+}
+body = .message
+if is_null(body) {
+  body = encode_json(.)
+}
+. = {
+  "resourceLogs": [{
+    "resource": {"attributes": resource},
+    "scopeLogs": [{
+      "logRecords": [{
+        "timeUnixNano": to_string(to_unix_timestamp(ts, unit: "nanoseconds")),
+        "observedTimeUnixNano": to_string(to_unix_timestamp(now(), unit: "nanoseconds")),
+        "severityText": level,
+        "severityNumber": severity,
+        "body": {"stringValue": to_string(body) ?? ""},
+        "attributes": attributes
+      }]
+    }]
+  }]
+}
+`),
+	}
+}
+
+func New(id string, o logging.OutputSpec, inputs []string, secret *corev1.Secret, op Options) []Element {
+	transformID := vectorhelpers.MakeID(id, "otlp")
+	batchID := vectorhelpers.MakeID(id, "batch")
+	if genhelper.IsDebugOutput(op) {
+		return []Element{
+			Transform(transformID, inputs),
+			Debug(vectorhelpers.MakeID(id, "debug"), transformID),
+		}
+	}
+	return MergeElements(
+		[]Element{
+			Transform(transformID, inputs),
+			Batch(batchID, o, []string{transformID}),
+			Output(id, o, []string{batchID}),
+			common.NewBuffer(id, o),
+			Request(id, o),
+		},
+		http.TLSConf(id, o, secret, op),
+		http.BasicAuth(id, o, secret),
+		http.BearerTokenAuth(id, o, secret),
+	)
+}
+
+func Output(id string, o logging.OutputSpec, inputs []string) Element {
+	return OTLP{
+		ComponentID: id,
+		Inputs:      vectorhelpers.MakeInputs(inputs...),
+		URI:         URI(o.URL),
+		Compression: common.Compression(o, ""),
+	}
+}
+
+// URI returns the output URL, with the OTLP logs path if the URL has no path
+func URI(outputURL string) string {
+	u, err := url.Parse(outputURL)
+	if err != nil || strings.Trim(u.Path, "/") != "" {
+		return outputURL
+	}
+	u.Path = LogsPath
+	return u.String()
+}
+
+func Request(id string, o logging.OutputSpec) *common.Request {
+	timeout := http.DefaultHttpTimeoutSecs
+	headers := map[string]string{}
+	if o.OTLP != nil {
+		if o.OTLP.Timeout != 0 {
+			timeout = o.OTLP.Timeout
+		}
+		for k, v := range o.OTLP.Headers {
+			headers[k] = v
+		}
+	}
+	headers["Content-Type"] = "application/json"
+	req := common.NewRequest(id, o)
+	req.TimeoutSecs.Value = timeout
+	req.SetHeaders(headers)
+	return req
+}
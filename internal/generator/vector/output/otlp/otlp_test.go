@@ -0,0 +1,103 @@
+package otlp
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Generate vector config for OTLP output", func() {
+
+	It("should convert records and post them to the OTLP logs endpoint", func() {
+		output := logging.OutputSpec{
+			Type: logging.OutputTypeOTLP,
+			Name: "otel-collector",
+			URL:  "https://otel-collector.svc:4318",
+			Secret: &logging.OutputSecretSpec{
+				Name: "otel-collector",
+			},
+			OutputTypeSpec: logging.OutputTypeSpec{
+				OTLP: &logging.OTLP{
+					Headers: map[string]string{"X-Tenant": "team-a"},
+					Timeout: 30,
+				},
+			},
+		}
+		secret := &corev1.Secret{
+			Data: map[string][]byte{
+				"token": []byte("my-token"),
+			},
+		}
+		conf := New("otel_collector", output, []string{"pipeline_a"}, secret, framework.NoOptions)
+		Expect(conf[0]).To(Equal(Transform("otel_collector_otlp", []string{"pipeline_a"})))
+		Expect(`
+[transforms.otel_collector_batch]
+type = "reduce"
+inputs = ["otel_collector_otlp"]
+max_events = 100
+expire_after_ms = 1000
+merge_strategies.resourceLogs = "concat"
+
+[sinks.otel_collector]
+type = "http"
+inputs = ["otel_collector_batch"]
+uri = "https://otel-collector.svc:4318/v1/logs"
+method = "post"
+
+[sinks.otel_collector.encoding]
+codec = "json"
+
+[sinks.otel_collector.framing]
+method = "newline_delimited"
+
+[sinks.otel_collector.batch]
+max_events = 1
+
+[sinks.otel_collector.buffer]
+when_full = "drop_newest"
+
+[sinks.otel_collector.request]
+retry_attempts = 17
+timeout_secs = 30
+headers = {"Content-Type"="application/json","X-Tenant"="team-a"}
+
+# Bearer Auth Config
+[sinks.otel_collector.auth]
+strategy = "bearer"
+token = "my-token"
+`).To(EqualConfigFrom(conf[1:]))
+	})
+
+	It("should batch records with the tuned batch size and timeout", func() {
+		output := logging.OutputSpec{
+			Type: logging.OutputTypeOTLP,
+			Name: "otel-collector",
+			URL:  "http://otel-collector.svc:4318",
+			Tuning: &logging.OutputTuningSpec{
+				MaxBatchEvents:   utils.GetPtr(int64(500)),
+				BatchTimeoutSecs: utils.GetPtr(int64(5)),
+			},
+		}
+		Expect(`
+[transforms.otel_collector_batch]
+type = "reduce"
+inputs = ["otel_collector_otlp"]
+max_events = 500
+expire_after_ms = 5000
+merge_strategies.resourceLogs = "concat"
+`).To(EqualConfigFrom(Batch("otel_collector_batch", output, []string{"otel_collector_otlp"})))
+	})
+
+	DescribeTable("#URI", func(url, exp string) {
+		Expect(URI(url)).To(Equal(exp))
+	},
+		Entry("should add the logs path", "http://collector:4318", "http://collector:4318/v1/logs"),
+		Entry("should add the logs path to a root path", "http://collector:4318/", "http://collector:4318/v1/logs"),
+		Entry("should keep a custom path", "https://gateway.example.com/otlp/v1/logs", "https://gateway.example.com/otlp/v1/logs"),
+	)
+})
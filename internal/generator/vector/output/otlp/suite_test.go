@@ -0,0 +1,13 @@
+package otlp
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestOTLP(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[generator][vector][output][otlp] Unit Tests")
+}
@@ -0,0 +1,105 @@
+package s3
+
+import (
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	genhelper "github.com/openshift/cluster-logging-operator/internal/generator/helpers"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/cloudwatch"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// DefaultKeyPrefix groups objects by log type and day
+	DefaultKeyPrefix = "{.log_type}/%Y/%m/%d/"
+
+	// KeyPrefixField is the record field holding the rendered key prefix, it is not written to the bucket
+	KeyPrefixField = "s3_key_prefix"
+)
+
+type S3 struct {
+	Desc           string
+	ComponentID    string
+	Inputs         string
+	Region         string
+	Bucket         string
+	Compression    string
+	EndpointConfig Element
+	SecurityConfig Element
+}
+
+func (e S3) Name() string {
+	return "s3Template"
+}
+
+func (e S3) Template() string {
+	return `{{define "` + e.Name() + `" -}}
+{{if .Desc -}}
+# {{.Desc}}
+{{end -}}
+[sinks.{{.ComponentID}}]
+type = "aws_s3"
+inputs = {{.Inputs}}
+region = "{{.Region}}"
+bucket = "{{.Bucket}}"
+key_prefix = "{{"{{ ` + KeyPrefixField + ` }}"}}"
+compression = "{{.Compression}}"
+{{compose_one .SecurityConfig}}
+encoding.codec = "json"
+encoding.except_fields = ["` + KeyPrefixField + `"]
+framing.method = "newline_delimited"
+healthcheck.enabled = false
+{{compose_one .EndpointConfig}}
+{{- end}}
+`
+}
+
+func New(id string, o logging.OutputSpec, inputs []string, secret *corev1.Secret, op Options) []Element {
+	keyPrefixID := helpers.MakeID(id, "key_prefix")
+	if genhelper.IsDebugOutput(op) {
+		return []Element{
+			KeyPrefix(keyPrefixID, inputs, o),
+			Debug(id, helpers.MakeInputs([]string{keyPrefixID}...)),
+		}
+	}
+	return MergeElements(
+		[]Element{
+			KeyPrefix(keyPrefixID, inputs, o),
+			Output(id, o, []string{keyPrefixID}, secret),
+			common.NewBuffer(id, o),
+			common.NewRequest(id, o),
+		},
+		common.NewBatch(id, o),
+		cloudwatch.TLSConf(id, o, secret, op),
+	)
+}
+
+func Output(id string, o logging.OutputSpec, inputs []string, secret *corev1.Secret) Element {
+	return S3{
+		Desc:           "Amazon S3",
+		ComponentID:    id,
+		Inputs:         helpers.MakeInputs(inputs...),
+		Region:         o.S3.Region,
+		Bucket:         o.S3.Bucket,
+		Compression:    common.Compression(o, "gzip"),
+		SecurityConfig: cloudwatch.SecurityConfig(secret),
+		EndpointConfig: cloudwatch.EndpointConfig(o),
+	}
+}
+
+// KeyPrefix renders the object key prefix template of the output into the KeyPrefixField of each record
+func KeyPrefix(id string, inputs []string, o logging.OutputSpec) Element {
+	prefix := DefaultKeyPrefix
+	if o.S3 != nil && o.S3.KeyPrefix != "" {
+		prefix = o.S3.KeyPrefix
+	}
+	return Remap{
+		Desc:        "S3 Key Prefix",
+		ComponentID: id,
+		Inputs:      helpers.MakeInputs(inputs...),
+		VRL: `ts = timestamp(."@timestamp") ?? parse_timestamp(string(."@timestamp") ?? "", "%+") ?? now()
+.` + KeyPrefixField + ` = ` + helpers.TimeTemplateToVRL(prefix, "ts"),
+	}
+}
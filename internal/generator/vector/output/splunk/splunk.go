@@ -16,6 +16,15 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
+const (
+	// SourceField, SourceTypeField and HostField are the record fields holding the rendered metadata templates
+	SourceField     = "splunk_source"
+	SourceTypeField = "splunk_sourcetype"
+	HostField       = "splunk_host"
+
+	writeIndexField = "write_index"
+)
+
 var (
 	splunkEncodingJson = fmt.Sprintf("%q", "json")
 )
@@ -25,7 +34,11 @@ type Splunk struct {
 	Inputs       string
 	Endpoint     string
 	DefaultToken string
+	Compression  string
 	Index        Element
+	Source       Element
+	SourceType   Element
+	HostKey      Element
 }
 
 func (s Splunk) Name() string {
@@ -38,13 +51,40 @@ func (s Splunk) Template() string {
 type = "splunk_hec_logs"
 inputs = {{.Inputs}}
 endpoint = "{{.Endpoint}}"
-compression = "none"
+compression = "{{.Compression}}"
 default_token = "{{.DefaultToken}}"
 {{kv .Index -}}
+{{kv .Source -}}
+{{kv .SourceType -}}
+{{kv .HostKey -}}
 timestamp_key = "@timestamp"
 {{end}}`
 }
 
+type Acknowledgements struct {
+	ComponentID   string
+	Enabled       bool
+	QueryInterval int
+	RetryLimit    int
+}
+
+func (a Acknowledgements) Name() string {
+	return "splunkAcknowledgements"
+}
+
+func (a Acknowledgements) Template() string {
+	return `{{define "` + a.Name() + `" -}}
+[sinks.{{.ComponentID}}.acknowledgements]
+indexer_acknowledgements_enabled = {{.Enabled}}
+{{- if .QueryInterval }}
+query_interval = {{.QueryInterval}}
+{{- end }}
+{{- if .RetryLimit }}
+retry_limit = {{.RetryLimit}}
+{{- end }}
+{{end}}`
+}
+
 type SplunkEncoding struct {
 	ComponentID  string
 	Codec        string
@@ -71,6 +111,7 @@ func New(id string, o logging.OutputSpec, inputs []string, secret *corev1.Secret
 	}
 
 	componentID := vectorhelpers.MakeID(id, "add_splunk_index")
+	metadataID := vectorhelpers.MakeID(id, "add_splunk_metadata")
 	dedottedID := vectorhelpers.MakeID(id, "dedot")
 
 	dedotInputs := inputs
@@ -78,27 +119,103 @@ func New(id string, o logging.OutputSpec, inputs []string, secret *corev1.Secret
 	if len(indexRemapElement) != 0 {
 		dedotInputs = []string{componentID}
 	}
+	metadataRemapElement := SetSplunkMetadataRemap(o.Splunk, metadataID, dedotInputs)
+	if len(metadataRemapElement) != 0 {
+		dedotInputs = []string{metadataID}
+	}
 
 	return MergeElements(
 		indexRemapElement,
+		metadataRemapElement,
 		[]Element{
 			normalize.DedotLabels(dedottedID, dedotInputs),
 			Output(id, o, []string{dedottedID}, secret, op),
 			Encoding(id, o),
-			common.NewBuffer(id),
-			common.NewRequest(id),
+			common.NewBuffer(id, o),
+			common.NewRequest(id, o),
 		},
+		Acks(id, o.Splunk),
+		common.NewBatch(id, o),
 		TLSConf(id, o, secret, op),
 	)
 }
 
 func Output(id string, o logging.OutputSpec, inputs []string, secret *corev1.Secret, op Options) Element {
+	templates := metadataTemplates(o.Splunk)
 	return Splunk{
 		ComponentID:  id,
 		Inputs:       vectorhelpers.MakeInputs(inputs...),
 		Endpoint:     o.URL,
 		DefaultToken: common.GetFromSecret(secret, constants.SplunkHECTokenKey),
+		Compression:  common.Compression(o, "none"),
 		Index:        AddSplunkIndexToSink(o.Splunk),
+		Source:       templateKV(templates, "source", SourceField),
+		SourceType:   templateKV(templates, "sourcetype", SourceTypeField),
+		HostKey:      hostKey(templates),
+	}
+}
+
+// metadataTemplates are the spec'd source, sourcetype and host templates keyed by the field holding their value
+func metadataTemplates(s *logging.Splunk) map[string]string {
+	templates := map[string]string{}
+	if s == nil {
+		return templates
+	}
+	for field, template := range map[string]string{SourceField: s.Source, SourceTypeField: s.SourceType, HostField: s.Host} {
+		if template != "" {
+			templates[field] = template
+		}
+	}
+	return templates
+}
+
+func templateKV(templates map[string]string, key, field string) Element {
+	if _, ok := templates[field]; !ok {
+		return Nil
+	}
+	return KV(key, fmt.Sprintf("%q", "{{ "+field+" }}"))
+}
+
+func hostKey(templates map[string]string) Element {
+	if _, ok := templates[HostField]; !ok {
+		return Nil
+	}
+	return KV("host_key", fmt.Sprintf("%q", HostField))
+}
+
+// SetSplunkMetadataRemap renders the source, sourcetype and host templates into record fields
+func SetSplunkMetadataRemap(s *logging.Splunk, componentID string, inputs []string) []Element {
+	templates := metadataTemplates(s)
+	if len(templates) == 0 {
+		return []Element{}
+	}
+	vrl := []string{}
+	for _, field := range []string{SourceField, SourceTypeField, HostField} {
+		if template, ok := templates[field]; ok {
+			vrl = append(vrl, fmt.Sprintf(".%s = %s", field, vectorhelpers.TemplateToVRL(template)))
+		}
+	}
+	return []Element{
+		Remap{
+			Desc:        "Set Splunk Metadata",
+			ComponentID: componentID,
+			Inputs:      vectorhelpers.MakeInputs(inputs...),
+			VRL:         strings.Join(vrl, "\n"),
+		},
+	}
+}
+
+func Acks(id string, s *logging.Splunk) []Element {
+	if s == nil || s.Acknowledgements == nil {
+		return []Element{}
+	}
+	return []Element{
+		Acknowledgements{
+			ComponentID:   id,
+			Enabled:       s.Acknowledgements.Enabled,
+			QueryInterval: s.Acknowledgements.QueryInterval,
+			RetryLimit:    s.Acknowledgements.RetryLimit,
+		},
 	}
 }
 
@@ -152,11 +269,21 @@ func AddSplunkIndexToSink(s *logging.Splunk) Element {
 }
 
 func AddSplunkEncodeExceptFields(s *logging.Splunk) Element {
-	if !hasCustomIndex(s) {
+	fields := []string{}
+	if hasCustomIndex(s) {
+		fields = append(fields, fmt.Sprintf("%q", writeIndexField))
+	}
+	templates := metadataTemplates(s)
+	for _, field := range []string{SourceField, SourceTypeField, HostField} {
+		if _, ok := templates[field]; ok {
+			fields = append(fields, fmt.Sprintf("%q", field))
+		}
+	}
+	if len(fields) == 0 {
 		return Nil
 	}
 
-	return KV("except_fields", "[\"write_index\"]")
+	return KV("except_fields", "["+strings.Join(fields, ",")+"]")
 }
 
 func Encoding(id string, o logging.OutputSpec) Element {
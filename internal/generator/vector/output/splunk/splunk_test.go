@@ -430,6 +430,87 @@ source = '''
 				Expect(results).To(EqualTrimLines(splunkIndexRemap + splunkSinkIndexName + splunkWithIndexDedot))
 			})
 		})
+
+		Context("with metadata templates and acknowledgements", func() {
+			var splunkOutputSpec loggingv1.OutputSpec
+			BeforeEach(func() {
+				splunkOutputSpec = loggingv1.OutputSpec{
+					Type: loggingv1.OutputTypeSplunk,
+					Name: "splunk_hec",
+					URL:  "https://splunk-web:8088/endpoint",
+					OutputTypeSpec: loggingv1.OutputTypeSpec{
+						Splunk: &loggingv1.Splunk{
+							IndexName:  "custom-index",
+							Source:     `{.kubernetes.namespace_name||"none"}:{.kubernetes.container_name||"none"}`,
+							SourceType: `openshift:{.log_type||"unknown"}`,
+							Host:       `{.hostname||"unknown"}`,
+							Acknowledgements: &loggingv1.SplunkAcknowledgements{
+								Enabled:       true,
+								QueryInterval: 5,
+								RetryLimit:    60,
+							},
+						},
+					},
+					Secret: &loggingv1.OutputSecretSpec{
+						Name: "vector-splunk-secret",
+					},
+				}
+			})
+
+			It("should render the templates into record fields", func() {
+				Expect(`
+# Set Splunk Metadata
+[transforms.splunk_hec_add_splunk_metadata]
+type = "remap"
+inputs = ["splunk_hec_add_splunk_index"]
+source = '''
+  .splunk_source = (string(.kubernetes.namespace_name) ?? "none") + ":" + (string(.kubernetes.container_name) ?? "none")
+  .splunk_sourcetype = "openshift:" + (string(.log_type) ?? "unknown")
+  .splunk_host = (string(.hostname) ?? "unknown")
+'''
+`).To(EqualConfigFrom(SetSplunkMetadataRemap(splunkOutputSpec.Splunk, "splunk_hec_add_splunk_metadata", []string{"splunk_hec_add_splunk_index"})))
+			})
+
+			It("should set the sink metadata from the record fields and not encode them", func() {
+				conf := []framework.Element{
+					Output("splunk_hec", splunkOutputSpec, []string{"splunk_hec_dedot"}, secrets[output.Secret.Name], nil),
+					Encoding("splunk_hec", splunkOutputSpec),
+				}
+				Expect(`
+[sinks.splunk_hec]
+type = "splunk_hec_logs"
+inputs = ["splunk_hec_dedot"]
+endpoint = "https://splunk-web:8088/endpoint"
+compression = "none"
+default_token = "` + hecToken + `"
+index = "{{ write_index }}"
+source = "{{ splunk_source }}"
+sourcetype = "{{ splunk_sourcetype }}"
+host_key = "splunk_host"
+timestamp_key = "@timestamp"
+
+[sinks.splunk_hec.encoding]
+codec = "json"
+except_fields = ["write_index","splunk_source","splunk_sourcetype","splunk_host"]
+`).To(EqualConfigFrom(conf))
+			})
+
+			It("should configure indexer acknowledgements", func() {
+				Expect(`
+[sinks.splunk_hec.acknowledgements]
+indexer_acknowledgements_enabled = true
+query_interval = 5
+retry_limit = 60
+`).To(EqualConfigFrom(Acks("splunk_hec", splunkOutputSpec.Splunk)))
+			})
+
+			It("should chain the metadata remap between the index remap and dedot", func() {
+				element := New(vectorhelpers.FormatComponentID(output.Name), splunkOutputSpec, []string{"pipelineName"}, secrets[output.Secret.Name], nil)
+				results, err := g.GenerateConf(element...)
+				Expect(err).To(BeNil())
+				Expect(results).To(ContainSubstring(`inputs = ["splunk_hec_add_splunk_metadata"]`))
+			})
+		})
 	})
 })
 
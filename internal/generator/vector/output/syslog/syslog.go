@@ -6,6 +6,8 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
 	"net/url"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
@@ -19,13 +21,40 @@ import (
 const (
 	TCP     = `tcp`
 	TLS     = `tls`
+	UDP     = `udp`
 	RFC3164 = `rfc3164`
 	RFC5424 = `rfc5424`
+
+	// PayloadField is the record field holding the JSON encoded record sent as MSG part of udp records
+	// with a MaxMessageSize and without PayloadKey
+	PayloadField = "_syslog_payload"
+)
+
+var (
+	framingMethods = map[string]string{
+		logging.SyslogFramingNewline: "newline_delimited",
+		// The length is prefixed by RenderMessage
+		logging.SyslogFramingOctetCounting: "bytes",
+	}
+	sdEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+	facilities = map[string]int{
+		"kernel": 0, "kern": 0, "user": 1, "mail": 2, "daemon": 3, "auth": 4, "syslog": 5, "lpr": 6, "news": 7,
+		"uucp": 8, "cron": 9, "authpriv": 10, "ftp": 11, "ntp": 12, "security": 13, "console": 14, "solaris-cron": 15,
+		"local0": 16, "local1": 17, "local2": 18, "local3": 19, "local4": 20, "local5": 21, "local6": 22, "local7": 23,
+	}
+	severities = map[string]int{
+		"emergency": 0, "emerg": 0, "alert": 1, "critical": 2, "crit": 2, "error": 3, "err": 3,
+		"warning": 4, "warn": 4, "notice": 5, "informational": 6, "info": 6, "debug": 7,
+	}
+	// headerRe matches the characters not allowed in the fields of the RFC5424 header
+	headerRe = regexp.MustCompile(`[^!-~]`)
 )
 
 type Syslog struct {
 	ComponentID string
 	Inputs      string
+	SinkInputs  string
 	Address     string
 	Mode        string
 }
@@ -45,7 +74,7 @@ source = '''
 
 [sinks.{{.ComponentID}}]
 type = "socket"
-inputs = ["{{.ComponentID}}_json"]
+inputs = {{.SinkInputs}}
 address = "{{.Address}}"
 mode = "{{.Mode}}"
 {{end}}`
@@ -84,6 +113,38 @@ severity = "{{.Severity}}"
 {{end}}`
 }
 
+// TextEncoding sends the message rendered by RenderMessage
+type TextEncoding struct {
+	ComponentID string
+}
+
+func (te TextEncoding) Name() string {
+	return "syslogTextEncoding"
+}
+
+func (te TextEncoding) Template() string {
+	return `{{define "` + te.Name() + `" -}}
+[sinks.{{.ComponentID}}.encoding]
+codec = "text"
+{{end}}`
+}
+
+type SyslogFraming struct {
+	ComponentID string
+	Method      string
+}
+
+func (sf SyslogFraming) Name() string {
+	return "syslogFraming"
+}
+
+func (sf SyslogFraming) Template() string {
+	return `{{define "` + sf.Name() + `" -}}
+[sinks.{{.ComponentID}}.framing]
+method = "{{.Method}}"
+{{end}}`
+}
+
 func Conf(o logging.OutputSpec, inputs []string, secret *corev1.Secret, op Options) []Element {
 	id := vectorhelpers.FormatComponentID(o.Name)
 	return New(id, o, inputs, secret, op)
@@ -97,17 +158,55 @@ func New(id string, o logging.OutputSpec, inputs []string, secret *corev1.Secret
 	}
 	u, _ := url.Parse(o.URL)
 	dedottedID := vectorhelpers.MakeID(id, "dedot")
+	output := Output(id, o, []string{dedottedID}, secret, op, u.Scheme, u.Host)
+	elements := []Element{
+		normalize.DedotLabels(dedottedID, inputs),
+		output,
+	}
+	formatID := vectorhelpers.MakeID(id, "format")
+	switch {
+	case RendersMessage(o.Syslog):
+		elements = append(elements, Remap{
+			Desc:        "Render the syslog message",
+			ComponentID: formatID,
+			Inputs:      vectorhelpers.MakeInputs(output.ComponentID + "_json"),
+			VRL:         RenderMessage(o.Syslog, output.Mode),
+		}, TextEncoding{
+			ComponentID: id,
+		})
+		output.SinkInputs = vectorhelpers.MakeInputs(formatID)
+		elements[1] = output
+	default:
+		if vrl := FormatMessage(o.Syslog, output.Mode); vrl != "" {
+			elements = append(elements, Remap{
+				Desc:        "Limit the message size",
+				ComponentID: formatID,
+				Inputs:      vectorhelpers.MakeInputs(output.ComponentID + "_json"),
+				VRL:         vrl,
+			})
+			output.SinkInputs = vectorhelpers.MakeInputs(formatID)
+			elements[1] = output
+		}
+		encoding := Encoding(id, o)
+		if encodesPayload(o.Syslog, output.Mode) {
+			encoding.PayloadKey = KV("payload_key", fmt.Sprintf(`"%s"`, PayloadField))
+		}
+		elements = append(elements, encoding)
+	}
+	if framing := Framing(id, o.Syslog, output.Mode); framing != nil {
+		elements = append(elements, framing)
+	}
+	// Syslog keeps the collector default buffer unless tuned
+	if o.Tuning != nil && o.Tuning.Buffer != nil {
+		elements = append(elements, common.NewBuffer(id, o))
+	}
 	return MergeElements(
-		[]Element{
-			normalize.DedotLabels(dedottedID, inputs),
-			Output(id, o, []string{dedottedID}, secret, op, u.Scheme, u.Host),
-			Encoding(id, o),
-		},
+		elements,
 		TLSConf(id, o, secret, op),
 	)
 }
 
-func Output(id string, o logging.OutputSpec, inputs []string, secret *corev1.Secret, op Options, urlScheme string, host string) Element {
+func Output(id string, o logging.OutputSpec, inputs []string, secret *corev1.Secret, op Options, urlScheme string, host string) Syslog {
 	var mode = strings.ToLower(urlScheme)
 	if urlScheme == TLS {
 		mode = TCP
@@ -115,12 +214,199 @@ func Output(id string, o logging.OutputSpec, inputs []string, secret *corev1.Sec
 	return Syslog{
 		ComponentID: id,
 		Inputs:      vectorhelpers.MakeInputs(inputs...),
+		SinkInputs:  vectorhelpers.MakeInputs(id + "_json"),
 		Address:     host,
 		Mode:        mode,
 	}
 }
 
-func Encoding(id string, o logging.OutputSpec) Element {
+// FormatMessage is the VRL truncating the payload of udp records encoded by the syslog codec to MaxMessageSize
+// bytes. Without PayloadKey the JSON encoded record is truncated into PayloadField
+func FormatMessage(s *logging.Syslog, mode string) string {
+	if s == nil {
+		return ""
+	}
+	vrl := []string{}
+	if encodesPayload(s, mode) {
+		vrl = append(vrl, fmt.Sprintf(`.%s = encode_json(.)`, PayloadField))
+	}
+	if s.MaxMessageSize > 0 && mode == UDP {
+		payload := payloadPath(s)
+		// slice cuts bytes, a character split by the cut is decoded as replacement character and removed
+		vrl = append(vrl, fmt.Sprintf(`if %s != null {
+  %s = to_string(%s) ?? encode_json(%s)
+  if length(%s) > %d {
+    %s = replace(slice!(%s, 0, %d), r'\x{FFFD}$', "")
+  }
+}`, payload, payload, payload, payload, payload, s.MaxMessageSize, payload, payload, s.MaxMessageSize))
+	}
+	return strings.Join(vrl, "\n")
+}
+
+// encodesPayload is true if the record is JSON encoded into PayloadField to limit the size of udp records
+func encodesPayload(s *logging.Syslog, mode string) bool {
+	return s != nil && s.MaxMessageSize > 0 && mode == UDP && s.PayloadKey == "" && !RendersMessage(s)
+}
+
+// RendersMessage is true if the message is rendered by RenderMessage instead of the syslog codec, which
+// does not support the STRUCTURED-DATA element and octet counting framing
+func RendersMessage(s *logging.Syslog) bool {
+	return s != nil && ((s.StructuredData != nil && len(s.StructuredData.Params) > 0) || s.Framing == logging.SyslogFramingOctetCounting)
+}
+
+// RenderMessage is the VRL rendering the RFC5424 message of the record into the message field sent by the text
+// codec. The MSG part of udp records is truncated to MaxMessageSize bytes, octet counting prefixes the length
+func RenderMessage(s *logging.Syslog, mode string) string {
+	vrl := []string{}
+	if s.PayloadKey == "" {
+		vrl = append(vrl, `msg = encode_json(.)`)
+	} else {
+		payload := payloadPath(s)
+		vrl = append(vrl, fmt.Sprintf(`msg = ""
+if %s != null {
+  msg = to_string(%s) ?? encode_json(%s)
+}`, payload, payload, payload))
+	}
+	if s.MaxMessageSize > 0 && mode == UDP {
+		vrl = append(vrl, fmt.Sprintf(`if length(msg) > %d {
+  msg = replace(slice!(msg, 0, %d), r'\x{FFFD}$', "")
+}`, s.MaxMessageSize, s.MaxMessageSize))
+	}
+	sd := `sd = "-"`
+	if s.StructuredData != nil && len(s.StructuredData.Params) > 0 {
+		sd = StructuredData(s.StructuredData)
+	}
+	vrl = append(vrl,
+		sd,
+		priorityCode("facility", s.Facility, facilities, facilities["user"]),
+		priorityCode("severity", s.Severity, severities, severities["informational"]),
+		`ts = ."@timestamp"
+if is_string(ts) {
+  ts = parse_timestamp(ts, "%+") ?? now()
+}
+ts = format_timestamp(ts, "%Y-%m-%dT%H:%M:%S%.6f%:z") ?? "-"`,
+		headerField("hostname", "$.hostname", 255),
+		headerField("app_name", s.AppName, 48),
+		headerField("proc_id", s.ProcID, 128),
+		headerField("msg_id", s.MsgID, 32),
+		`message = "<" + to_string(facility * 8 + severity) + ">1 " + ts + " " + hostname + " " + app_name + " " + proc_id + " " + msg_id + " " + sd + " " + msg`,
+	)
+	if s.Framing == logging.SyslogFramingOctetCounting {
+		vrl = append(vrl, `message = to_string(length(message)) + " " + message`)
+	}
+	vrl = append(vrl, `. = {"message": message}`)
+	return strings.Join(vrl, "\n")
+}
+
+// priorityCode is the VRL setting the facility or severity code of the PRI part. Names and numbers of a
+// record field are resolved when the record is rendered, unknown values use the default code
+func priorityCode(name, value string, codes map[string]int, defaultCode int) string {
+	maxCode := 0
+	for _, code := range codes {
+		if code > maxCode {
+			maxCode = code
+		}
+	}
+	if !IsKeyExpr(value) {
+		code, err := strconv.Atoi(value)
+		if err != nil {
+			code, found := codes[strings.ToLower(value)]
+			if !found {
+				code = defaultCode
+			}
+			return fmt.Sprintf("%s = %d", name, code)
+		}
+		if code < 0 || code > maxCode {
+			code = defaultCode
+		}
+		return fmt.Sprintf("%s = %d", name, code)
+	}
+	names := make([]string, 0, len(codes))
+	for n := range codes {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	pairs := make([]string, 0, len(names))
+	for _, n := range names {
+		pairs = append(pairs, fmt.Sprintf("%q: %d", n, codes[n]))
+	}
+	path := strings.TrimPrefix(value, "$")
+	return fmt.Sprintf(`%s = downcase(to_string(%s) ?? "")
+%s = to_int(%s) ?? get!({%s}, [%s])
+%s = int(%s) ?? %d
+if %s < 0 || %s > %d {
+  %s = %d
+}`, name, path, name, name, strings.Join(pairs, ", "), name, name, name, defaultCode, name, name, maxCode, name, defaultCode)
+}
+
+// headerField is the VRL setting a field of the RFC5424 header to a value or the value of a record field,
+// characters other than printable US-ASCII are replaced and missing values are the NILVALUE
+func headerField(name, value string, maxLen int) string {
+	if !IsKeyExpr(value) {
+		value = headerRe.ReplaceAllString(value, "_")
+		if len(value) > maxLen {
+			value = value[:maxLen]
+		}
+		if value == "" {
+			value = "-"
+		}
+		return fmt.Sprintf(`%s = %q`, name, value)
+	}
+	path := strings.TrimPrefix(value, "$")
+	return fmt.Sprintf(`%s = to_string(%s) ?? ""
+%s = truncate(replace(%s, r'[^!-~]', "_"), %d)
+if %s == "" {
+  %s = "-"
+}`, name, path, name, name, maxLen, name, name)
+}
+
+// StructuredData is the VRL rendering the STRUCTURED-DATA element into the sd variable. Param values
+// are escaped as defined by RFC5424
+func StructuredData(sd *logging.SyslogStructuredData) string {
+	field := "sd"
+	names := make([]string, 0, len(sd.Params))
+	for name := range sd.Params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	vrl := []string{fmt.Sprintf(`%s = "[%s"`, field, sd.ID)}
+	for _, name := range names {
+		value := sd.Params[name]
+		if !IsKeyExpr(value) {
+			vrl = append(vrl, fmt.Sprintf(`%s = %s + %q`, field, field, fmt.Sprintf(` %s="%s"`, name, sdEscaper.Replace(value))))
+			continue
+		}
+		path := strings.TrimPrefix(value, "$")
+		vrl = append(vrl, fmt.Sprintf(`if %s != null {
+  value = to_string(%s) ?? encode_json(%s)
+  value = replace(replace(replace(value, "\\", "\\\\"), "\"", "\\\""), "]", "\\]")
+  %s = %s + " %s=\"" + value + "\""
+}`, path, path, path, field, field, name))
+	}
+	vrl = append(vrl, fmt.Sprintf(`%s = %s + "]"`, field, field))
+	return strings.Join(vrl, "\n")
+}
+
+// payloadPath is the path of the record field sent as MSG part
+func payloadPath(s *logging.Syslog) string {
+	if s.PayloadKey == "" {
+		return "." + PayloadField
+	}
+	return "." + strings.TrimPrefix(strings.TrimPrefix(s.PayloadKey, "$"), ".")
+}
+
+// Framing of tcp records when spec'd, udp records are sent as one datagram
+func Framing(id string, s *logging.Syslog, mode string) Element {
+	if s == nil || s.Framing == "" || mode == UDP {
+		return nil
+	}
+	return SyslogFraming{
+		ComponentID: id,
+		Method:      framingMethods[s.Framing],
+	}
+}
+
+func Encoding(id string, o logging.OutputSpec) SyslogEncoding {
 	return SyslogEncoding{
 		ComponentID:  id,
 		RFC:          RFC(o.Syslog),
@@ -136,7 +422,7 @@ func Encoding(id string, o logging.OutputSpec) Element {
 }
 
 func TLSConf(id string, o logging.OutputSpec, secret *corev1.Secret, op Options) []Element {
-	if o.Secret != nil {
+	if o.Secret != nil || o.TLS.HasReferences() {
 		if tlsConf := common.GenerateTLSConfWithID(id, o, secret, op, false); tlsConf != nil {
 			return []Element{tlsConf}
 		}
@@ -405,6 +405,149 @@ key_pass = "mysecretpassword"
 		})
 
 	})
+
+	Context("with structured data, framing and message size", func() {
+		var output loggingv1.OutputSpec
+		BeforeEach(func() {
+			output = loggingv1.OutputSpec{
+				Type: loggingv1.OutputTypeSyslog,
+				Name: "syslog-udp",
+				URL:  "udp://logserver:514",
+				OutputTypeSpec: loggingv1.OutputTypeSpec{
+					Syslog: &loggingv1.Syslog{
+						StructuredData: &loggingv1.SyslogStructuredData{
+							ID: "openshift@2312",
+							Params: map[string]string{
+								"namespace": "$.kubernetes.namespace_name",
+								"env":       `p"r]od`,
+							},
+						},
+						MaxMessageSize: 1024,
+						Framing:        loggingv1.SyslogFramingNewline,
+					},
+				},
+			}
+		})
+
+		It("should render the message with the structured data and truncate the JSON encoded udp records in bytes", func() {
+			Expect(`
+# Render the syslog message
+[transforms.syslog_udp_format]
+type = "remap"
+inputs = ["syslog_udp_json"]
+source = '''
+  msg = encode_json(.)
+  if length(msg) > 1024 {
+    msg = replace(slice!(msg, 0, 1024), r'\x{FFFD}$', "")
+  }
+  sd = "[openshift@2312"
+  sd = sd + " env=\"p\\\"r\\]od\""
+  if .kubernetes.namespace_name != null {
+    value = to_string(.kubernetes.namespace_name) ?? encode_json(.kubernetes.namespace_name)
+    value = replace(replace(replace(value, "\\", "\\\\"), "\"", "\\\""), "]", "\\]")
+    sd = sd + " namespace=\"" + value + "\""
+  }
+  sd = sd + "]"
+  facility = 1
+  severity = 6
+  ts = ."@timestamp"
+  if is_string(ts) {
+    ts = parse_timestamp(ts, "%+") ?? now()
+  }
+  ts = format_timestamp(ts, "%Y-%m-%dT%H:%M:%S%.6f%:z") ?? "-"
+  hostname = to_string(.hostname) ?? ""
+  hostname = truncate(replace(hostname, r'[^!-~]', "_"), 255)
+  if hostname == "" {
+    hostname = "-"
+  }
+  app_name = "-"
+  proc_id = "-"
+  msg_id = "-"
+  message = "<" + to_string(facility * 8 + severity) + ">1 " + ts + " " + hostname + " " + app_name + " " + proc_id + " " + msg_id + " " + sd + " " + msg
+  . = {"message": message}
+'''
+`).To(EqualConfigFrom(New("syslog_udp", output, []string{"pipelineName"}, nil, nil)[2]))
+		})
+
+		It("should send the rendered message", func() {
+			Expect(`
+[sinks.syslog_udp.encoding]
+codec = "text"
+`).To(EqualConfigFrom(New("syslog_udp", output, []string{"pipelineName"}, nil, nil)[3]))
+		})
+
+		It("should resolve the header fields of the record and prefix the length of octet counted tcp records", func() {
+			output.URL = "tcp://logserver:514"
+			output.Syslog.StructuredData = nil
+			output.Syslog.Framing = loggingv1.SyslogFramingOctetCounting
+			output.Syslog.Facility = "local0"
+			output.Syslog.Severity = "$.level"
+			output.Syslog.AppName = "my app"
+			output.Syslog.MsgID = "$.kubernetes.container_name"
+			Expect(RenderMessage(output.Syslog, TCP)).To(Equal(`msg = encode_json(.)
+sd = "-"
+facility = 16
+severity = downcase(to_string(.level) ?? "")
+severity = to_int(severity) ?? get!({"alert": 1, "crit": 2, "critical": 2, "debug": 7, "emerg": 0, "emergency": 0, "err": 3, "error": 3, "info": 6, "informational": 6, "notice": 5, "warn": 4, "warning": 4}, [severity])
+severity = int(severity) ?? 6
+if severity < 0 || severity > 7 {
+  severity = 6
+}
+ts = ."@timestamp"
+if is_string(ts) {
+  ts = parse_timestamp(ts, "%+") ?? now()
+}
+ts = format_timestamp(ts, "%Y-%m-%dT%H:%M:%S%.6f%:z") ?? "-"
+hostname = to_string(.hostname) ?? ""
+hostname = truncate(replace(hostname, r'[^!-~]', "_"), 255)
+if hostname == "" {
+  hostname = "-"
+}
+app_name = "my_app"
+proc_id = "-"
+msg_id = to_string(.kubernetes.container_name) ?? ""
+msg_id = truncate(replace(msg_id, r'[^!-~]', "_"), 32)
+if msg_id == "" {
+  msg_id = "-"
+}
+message = "<" + to_string(facility * 8 + severity) + ">1 " + ts + " " + hostname + " " + app_name + " " + proc_id + " " + msg_id + " " + sd + " " + msg
+message = to_string(length(message)) + " " + message
+. = {"message": message}`))
+			Expect(`
+[sinks.syslog_tcp.framing]
+method = "bytes"
+`).To(EqualConfigFrom(Framing("syslog_tcp", output.Syslog, TCP)))
+		})
+
+		It("should truncate the payload key of udp records in bytes", func() {
+			output.Syslog.StructuredData = nil
+			output.Syslog.PayloadKey = "$.message"
+			Expect(FormatMessage(output.Syslog, UDP)).To(Equal(`if .message != null {
+  .message = to_string(.message) ?? encode_json(.message)
+  if length(.message) > 1024 {
+    .message = replace(slice!(.message, 0, 1024), r'\x{FFFD}$', "")
+  }
+}`))
+			Expect(`
+[sinks.syslog_udp.encoding]
+codec = "syslog"
+rfc = "rfc5424"
+facility = "user"
+severity = "informational"
+payload_key = "$.message"
+`).To(EqualConfigFrom(New("syslog_udp", output, []string{"pipelineName"}, nil, nil)[3]))
+		})
+
+		It("should frame tcp records and not truncate them", func() {
+			output.Syslog.StructuredData = nil
+			Expect(FormatMessage(output.Syslog, TCP)).To(BeEmpty())
+			Expect(`
+[sinks.syslog_tcp.framing]
+method = "newline_delimited"
+`).To(EqualConfigFrom(Framing("syslog_tcp", output.Syslog, TCP)))
+			Expect(Framing("syslog_udp", output.Syslog, UDP)).To(BeNil())
+		})
+	})
 })
 
 func TestVectorConfGenerator(t *testing.T) {
@@ -1,31 +1,53 @@
 package source
 
 import (
+	"path/filepath"
 	"strings"
 
 	configv1 "github.com/openshift/api/config/v1"
 	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
 	"github.com/openshift/cluster-logging-operator/internal/tls"
 )
 
-func NewHttpSource(id, inputName string, input logging.InputSpec, op framework.Options) framework.Element {
+func NewHttpSource(id, inputName string, input logging.InputSpec, op framework.Options) HttpReceiver {
 	var minTlsVersion, cipherSuites string
 	if _, ok := op[framework.ClusterTLSProfileSpec]; ok {
 		tlsProfileSpec := op[framework.ClusterTLSProfileSpec].(configv1.TLSProfileSpec)
 		minTlsVersion = tls.MinTLSVersion(tlsProfileSpec)
 		cipherSuites = strings.Join(tls.TLSCiphers(tlsProfileSpec), `,`)
 	}
-	return HttpReceiver{
+	receiver := HttpReceiver{
 		ID:            id,
 		InputName:     inputName,
 		ListenAddress: helpers.ListenOnAllLocalInterfacesAddress(),
 		ListenPort:    input.Receiver.HTTP.Port,
 		Format:        input.Receiver.HTTP.Format,
+		Codec:         "json",
 		TlsMinVersion: minTlsVersion,
 		CipherSuites:  cipherSuites,
 	}
+	switch receiver.Format {
+	case logging.FormatJSON:
+		receiver.Framing = "bytes"
+	case logging.FormatNDJSON:
+		receiver.Framing = "newline_delimited"
+	case logging.FormatText:
+		receiver.Codec = "bytes"
+		receiver.Framing = "newline_delimited"
+	}
+	if ca := input.Receiver.HTTP.ClientCA; ca != nil {
+		receiver.CAFile = common.ValuePath(*ca)
+	}
+	if auth := input.Receiver.HTTP.Authentication; auth != nil {
+		receiver.Auth = auth.Type
+		receiver.SecretID = helpers.MakeID(id, "secret")
+		receiver.SecretPath = filepath.Join(constants.CollectorSecretsDir, auth.SecretName)
+	}
+	return receiver
 }
 
 type HttpReceiver struct {
@@ -34,10 +56,24 @@ type HttpReceiver struct {
 	ListenAddress string
 	ListenPort    int32
 	Format        string
+	Codec         string
+	Framing       string
+	CAFile        string
+	Auth          string
+	SecretID      string
+	SecretPath    string
 	TlsMinVersion string
 	CipherSuites  string
 }
 
+// OutputID is the id of the component that emits the authenticated records of the receiver
+func (i HttpReceiver) OutputID() string {
+	if i.Auth == logging.HTTPReceiverAuthBearerToken {
+		return helpers.MakeID(i.ID, "auth")
+	}
+	return i.ID
+}
+
 func (HttpReceiver) Name() string {
 	return "httpReceiver"
 }
@@ -45,25 +81,63 @@ func (HttpReceiver) Name() string {
 func (i HttpReceiver) Template() string {
 	return `
 {{define "` + i.Name() + `" -}}
+{{- if ne .SecretID "" -}}
+[secret.{{.SecretID}}]
+type = "directory"
+path = "{{.SecretPath}}"
+remove_trailing_whitespace = true
+
+{{ end -}}
 [sources.{{.ID}}]
 type = "http_server"
 address = "{{.ListenAddress}}:{{.ListenPort}}"
-decoding.codec = "json"
+decoding.codec = "{{.Codec}}"
+{{- if ne .Framing "" }}
+framing.method = "{{.Framing}}"
+{{- end }}
+{{- if eq .Auth "bearerToken" }}
+headers = ["Authorization"]
+{{- end }}
 
 [sources.{{.ID}}.tls]
 enabled = true
 key_file = "/etc/collector/receiver/{{.InputName}}/tls.key"
 crt_file = "/etc/collector/receiver/{{.InputName}}/tls.crt"
+{{- if ne .CAFile "" }}
+ca_file = {{.CAFile}}
+verify_certificate = true
+{{- end }}
 {{- if ne .TlsMinVersion "" }}
 min_tls_version = "{{ .TlsMinVersion }}"
 {{- end }}
 {{- if ne .CipherSuites "" }}
 ciphersuites = "{{ .CipherSuites }}"
 {{- end }}
+{{- if eq .Auth "basic" }}
 
-[transforms.{{.ID}}_split]
+[sources.{{.ID}}.auth]
+username = "SECRET[{{.SecretID}}.username]"
+password = "SECRET[{{.SecretID}}.password]"
+{{- end }}
+{{- if eq .Auth "bearerToken" }}
+
+# The http_server source only supports basic auth, posts are accepted and the records dropped
+[transforms.{{.OutputID}}]
 type = "remap"
 inputs = ["{{.ID}}"]
+drop_on_abort = true
+source = '''
+  if del(.Authorization) != "Bearer SECRET[{{.SecretID}}.token]" {
+    log("Dropping a record without a valid bearer token", level: "warn", rate_limit_secs: 60)
+    abort
+  }
+'''
+{{- end }}
+{{- if eq .Format "kubeAPIAudit" }}
+
+[transforms.{{.ID}}_split]
+type = "remap"
+inputs = ["{{.OutputID}}"]
 source = '''
   if exists(.items) && is_array(.items) {. = unnest!(.items)} else {.}
 '''
@@ -74,6 +148,7 @@ inputs = ["{{.ID}}_split"]
 source = '''
   if exists(.items) {. = .items} else {.}
 '''
+{{- end }}
 {{end}}
 `
 }
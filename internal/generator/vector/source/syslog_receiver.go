@@ -7,6 +7,7 @@ import (
 	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
 	"github.com/openshift/cluster-logging-operator/internal/tls"
 )
 
@@ -17,14 +18,26 @@ func NewSyslogSource(id, inputName string, input logging.InputSpec, op framework
 		minTlsVersion = tls.MinTLSVersion(tlsProfileSpec)
 		cipherSuites = strings.Join(tls.TLSCiphers(tlsProfileSpec), `,`)
 	}
-	return SyslogReceiver{
+	syslog := input.Receiver.Syslog
+	receiver := SyslogReceiver{
 		ID:            id,
 		InputName:     inputName,
 		ListenAddress: helpers.ListenOnAllLocalInterfacesAddress(),
-		ListenPort:    input.Receiver.Syslog.Port,
+		ListenPort:    syslog.Port,
+		Mode:          logging.SyslogReceiverProtocolTCP,
 		TlsMinVersion: minTlsVersion,
 		CipherSuites:  cipherSuites,
 	}
+	switch syslog.GetProtocol() {
+	case logging.SyslogReceiverProtocolUDP:
+		receiver.Mode = logging.SyslogReceiverProtocolUDP
+	case logging.SyslogReceiverProtocolTLS:
+		receiver.TLS = true
+		if syslog.ClientCA != nil {
+			receiver.CAFile = common.ValuePath(*syslog.ClientCA)
+		}
+	}
+	return receiver
 }
 
 type SyslogReceiver struct {
@@ -32,6 +45,9 @@ type SyslogReceiver struct {
 	InputName     string
 	ListenAddress string
 	ListenPort    int32
+	Mode          string
+	TLS           bool
+	CAFile        string
 	TlsMinVersion string
 	CipherSuites  string
 }
@@ -46,18 +62,24 @@ func (i SyslogReceiver) Template() string {
 [sources.{{.ID}}]
 type = "syslog"
 address = "{{.ListenAddress}}:{{.ListenPort}}"
-mode = "tcp"
+mode = "{{.Mode}}"
+{{- if .TLS }}
 
 [sources.{{.ID}}.tls]
 enabled = true
 key_file = "/etc/collector/receiver/{{.InputName}}/tls.key"
 crt_file = "/etc/collector/receiver/{{.InputName}}/tls.crt"
+{{- if ne .CAFile "" }}
+ca_file = {{.CAFile}}
+verify_certificate = true
+{{- end }}
 {{- if ne .TlsMinVersion "" }}
 min_tls_version = "{{ .TlsMinVersion }}"
 {{- end }}
 {{- if ne .CipherSuites "" }}
 ciphersuites = "{{ .CipherSuites }}"
 {{- end }}
+{{- end }}
 {{end}}
 `
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
 	"github.com/openshift/cluster-logging-operator/internal/validations/clusterlogforwarder"
 	"github.com/openshift/cluster-logging-operator/internal/validations/clusterlogging"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -61,7 +62,8 @@ func FetchClusterLogForwarder(k8sClient client.Client, namespace, name string, i
 	// TODO Drop migration upon introduction of v2
 	extras := map[string]bool{}
 	var migrationMessages []logging.Condition
-	forwarder.Spec, extras, migrationMessages = migrations.MigrateClusterLogForwarder(namespace, name, forwarder.Spec, fetchClusterLogging().Spec.LogStore, extras, internalLogStoreSecret, saTokenSecret)
+	secrets := fetchOutputSecrets(k8sClient, namespace, forwarder.Spec.Outputs)
+	forwarder.Spec, extras, migrationMessages = migrations.MigrateClusterLogForwarder(namespace, name, forwarder.Spec, fetchClusterLogging().Spec.LogStore, extras, internalLogStoreSecret, saTokenSecret, secrets)
 	setMigrationStatusConditions(&forwarder.Status, migrationMessages)
 
 	extras[constants.ClusterLoggingAvailable] = (fetchClusterLogging().Name != "")
@@ -72,10 +74,32 @@ func FetchClusterLogForwarder(k8sClient client.Client, namespace, name string, i
 	if err, status = clusterlogforwarder.Validate(forwarder, k8sClient, extras); err != nil {
 		return forwarder, err, status
 	}
+	if status.Conditions.IsTrueFor(logging.ConditionDegraded) {
+		// Only forward the valid pipelines
+		forwarder.Spec = clusterlogforwarder.ReadyPipelines(forwarder.Spec, *status)
+	}
 
 	return forwarder, nil, status
 }
 
+// fetchOutputSecrets returns the secrets of the outputs keyed by output name. Secrets that can not be
+// fetched are skipped, they are reported by validation
+func fetchOutputSecrets(k8sClient client.Client, namespace string, outputs []logging.OutputSpec) map[string]*corev1.Secret {
+	secrets := map[string]*corev1.Secret{}
+	for _, output := range outputs {
+		if output.Secret == nil || output.Secret.Name == "" {
+			continue
+		}
+		secret := &corev1.Secret{}
+		if err := k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: output.Secret.Name}, secret); err != nil {
+			log.V(3).Info("Unable to fetch output secret for migration", "output", output.Name, "error", err.Error())
+			continue
+		}
+		secrets[output.Name] = secret
+	}
+	return secrets
+}
+
 func setMigrationStatusConditions(status *logging.ClusterLogForwarderStatus, conditions []logging.Condition) {
 	for _, cond := range conditions {
 		status.Conditions.SetCondition(cond)
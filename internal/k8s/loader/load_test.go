@@ -42,4 +42,43 @@ var _ = Describe("#FetchClusterLogForwarder", func() {
 			Expect(err).ToNot(BeNil(), "legacy CLF without CL should fail with validation errors")
 		})
 	})
+
+	Context("when an output secret uses the deprecated SASL keys", func() {
+
+		BeforeEach(func() {
+			clf = runtime.NewClusterLogForwarder("my-ns", "my-forwarder")
+			clf.Spec = logging.ClusterLogForwarderSpec{
+				ServiceAccountName: "my-sa",
+				Outputs: []logging.OutputSpec{
+					{
+						Name:   "kafka",
+						Type:   logging.OutputTypeKafka,
+						URL:    "tls://broker:9093/topic",
+						Secret: &logging.OutputSecretSpec{Name: "kafka-secret"},
+					},
+				},
+				Pipelines: []logging.PipelineSpec{
+					{
+						Name:       "app-to-kafka",
+						InputRefs:  []string{logging.InputNameApplication},
+						OutputRefs: []string{"kafka"},
+					},
+				},
+			}
+			secret := runtime.NewSecret("my-ns", "kafka-secret", map[string][]byte{
+				"sasl.enable":     []byte("true"),
+				"sasl.mechanisms": []byte("SCRAM-SHA-512"),
+				"username":        []byte("user"),
+				"password":        []byte("pass"),
+			})
+			k8Client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(clf, secret).Build()
+		})
+
+		It("should migrate them before the forwarder is validated", func() {
+			fetchCL := func() logging.ClusterLogging { return logging.ClusterLogging{} }
+			forwarder, _, _ := FetchClusterLogForwarder(k8Client, clf.Namespace, clf.Name, true, fetchCL)
+			Expect(forwarder.Spec.Outputs).To(HaveLen(1))
+			Expect(forwarder.Spec.Outputs[0].Kafka).To(Equal(&logging.Kafka{SASL: &logging.KafkaSASL{Mechanism: "SCRAM-SHA-512"}}))
+		})
+	})
 })
@@ -94,6 +94,11 @@ func (clusterRequest *ClusterLoggingRequest) CreateOrUpdateCollection() (err err
 		return err
 	}
 
+	if err := clusterRequest.SetInputAddresses(); err != nil {
+		log.Error(err, "collector.SetInputAddresses")
+		return err
+	}
+
 	if err := metrics.ReconcileServiceMonitor(clusterRequest.EventRecorder, clusterRequest.Client, clusterRequest.Forwarder.Namespace, clusterRequest.ResourceNames.CommonName, constants.CollectorName, collector.MetricsPortName, clusterRequest.ResourceOwner); err != nil {
 
 		log.Error(err, "collector.ReconcileServiceMonitor")
@@ -257,5 +262,58 @@ func (clusterRequest *ClusterLoggingRequest) RemoveInputServices(currOwner []met
 		}
 	}
 
+	// Collect defined inputs exposed by a route
+	routeInputs := sets.NewString()
+	for _, input := range clusterRequest.Forwarder.Spec.Inputs {
+		if input.Receiver != nil && input.Receiver.GetExposureType() == logging.ReceiverExposureRoute {
+			routeInputs.Insert(clusterRequest.ResourceNames.GenerateInputServiceName(input.Name))
+		}
+	}
+
+	// Remove routes only if owned by current CLF and the input isn't defined or exposed by a route
+	for _, component := range []string{constants.LabelHTTPInputService, constants.LabelSyslogInputService} {
+		routes, err := clusterRequest.GetRouteList(constants.LabelComponent, component, clusterRequest.Forwarder.Namespace)
+		if err != nil {
+			return err
+		}
+		for _, route := range routes.Items {
+			if utils.HasSameOwner(route.OwnerReferences, currOwner) && (!routeInputs.Has(route.Name) || removeAllServices) {
+				if err := clusterRequest.RemoveInputRoute(route.Name); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetInputAddresses sets the status of the receiver inputs exposed outside the cluster to the address
+// they are reachable at
+func (clusterRequest *ClusterLoggingRequest) SetInputAddresses() error {
+	if clusterRequest.Cluster.Spec.Collection.Type != logging.LogCollectionTypeVector {
+		return nil
+	}
+
+	status := &clusterRequest.Forwarder.Status
+	for _, input := range clusterRequest.Forwarder.Spec.Inputs {
+		if input.Receiver == nil || !status.Inputs[input.Name].IsTrueFor(logging.ConditionReady) {
+			continue
+		}
+		exposure := input.Receiver.GetExposureType()
+		if exposure == logging.ReceiverExposureClusterIP {
+			continue
+		}
+		name := clusterRequest.ResourceNames.GenerateInputServiceName(input.Name)
+		address, err := network.InputAddress(clusterRequest.Client, clusterRequest.Forwarder.Namespace, name, exposure)
+		if err != nil {
+			return err
+		}
+		if address == "" {
+			status.Inputs.SetCondition(input.Name, logging.ConditionReady, corev1.ConditionTrue, logging.ReasonExposed, "waiting for the address of the %s", exposure)
+			continue
+		}
+		status.Inputs.SetCondition(input.Name, logging.ConditionReady, corev1.ConditionTrue, logging.ReasonExposed, "reachable at %s", address)
+	}
 	return nil
 }
@@ -15,9 +15,11 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	configv1 "github.com/openshift/api/config/v1"
+	routev1 "github.com/openshift/api/route/v1"
 	securityv1 "github.com/openshift/api/security/v1"
 	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -149,7 +151,7 @@ var _ = Describe("Reconciling", func() {
 					isDaemonset:   true,
 				}
 				extras[constants.MigrateDefaultOutput] = true
-				spec, extras, _ = migrations.MigrateClusterLogForwarder(clusterRequest.Forwarder.Namespace, clusterRequest.Forwarder.Name, clusterRequest.Forwarder.Spec, clusterRequest.Cluster.Spec.LogStore, extras, clusterRequest.ResourceNames.InternalLogStoreSecret, clusterRequest.ResourceNames.ServiceAccountTokenSecret)
+				spec, extras, _ = migrations.MigrateClusterLogForwarder(clusterRequest.Forwarder.Namespace, clusterRequest.Forwarder.Name, clusterRequest.Forwarder.Spec, clusterRequest.Cluster.Spec.LogStore, extras, clusterRequest.ResourceNames.InternalLogStoreSecret, clusterRequest.ResourceNames.ServiceAccountTokenSecret, nil)
 				clusterRequest.Forwarder.Spec = spec
 			})
 
@@ -242,7 +244,7 @@ var _ = Describe("Reconciling", func() {
 					isDaemonset:   true,
 				}
 				extras[constants.MigrateDefaultOutput] = true
-				spec, extras, _ = migrations.MigrateClusterLogForwarder(clusterRequest.Forwarder.Namespace, clusterRequest.Forwarder.Name, clusterRequest.Forwarder.Spec, clusterRequest.Cluster.Spec.LogStore, extras, clusterRequest.ResourceNames.InternalLogStoreSecret, clusterRequest.ResourceNames.ServiceAccountTokenSecret)
+				spec, extras, _ = migrations.MigrateClusterLogForwarder(clusterRequest.Forwarder.Namespace, clusterRequest.Forwarder.Name, clusterRequest.Forwarder.Spec, clusterRequest.Cluster.Spec.LogStore, extras, clusterRequest.ResourceNames.InternalLogStoreSecret, clusterRequest.ResourceNames.ServiceAccountTokenSecret, nil)
 				clusterRequest.Forwarder.Spec = spec
 			})
 
@@ -303,7 +305,7 @@ var _ = Describe("Reconciling", func() {
 					Forwarder:     &loggingv1.ClusterLogForwarder{},
 				}
 				extras[constants.MigrateDefaultOutput] = true
-				spec, extras, _ = migrations.MigrateClusterLogForwarder(clusterRequest.Forwarder.Namespace, clusterRequest.Forwarder.Name, clusterRequest.Forwarder.Spec, clusterRequest.Cluster.Spec.LogStore, extras, clusterRequest.ResourceNames.InternalLogStoreSecret, clusterRequest.ResourceNames.ServiceAccountTokenSecret)
+				spec, extras, _ = migrations.MigrateClusterLogForwarder(clusterRequest.Forwarder.Namespace, clusterRequest.Forwarder.Name, clusterRequest.Forwarder.Spec, clusterRequest.Cluster.Spec.LogStore, extras, clusterRequest.ResourceNames.InternalLogStoreSecret, clusterRequest.ResourceNames.ServiceAccountTokenSecret, nil)
 				clusterRequest.Forwarder.Spec = spec
 			})
 		})
@@ -365,7 +367,7 @@ var _ = Describe("Reconciling", func() {
 					isDaemonset:   true,
 				}
 				extras[constants.MigrateDefaultOutput] = true
-				spec, extras, _ = migrations.MigrateClusterLogForwarder(clusterRequest.Forwarder.Namespace, clusterRequest.Forwarder.Name, clusterRequest.Forwarder.Spec, clusterRequest.Cluster.Spec.LogStore, extras, clusterRequest.ResourceNames.InternalLogStoreSecret, clusterRequest.ResourceNames.ServiceAccountTokenSecret)
+				spec, extras, _ = migrations.MigrateClusterLogForwarder(clusterRequest.Forwarder.Namespace, clusterRequest.Forwarder.Name, clusterRequest.Forwarder.Spec, clusterRequest.Cluster.Spec.LogStore, extras, clusterRequest.ResourceNames.InternalLogStoreSecret, clusterRequest.ResourceNames.ServiceAccountTokenSecret, nil)
 				clusterRequest.Forwarder.Spec = spec
 			})
 			It("should have appropriately named resources with daemonset", func() {
@@ -387,5 +389,127 @@ var _ = Describe("Reconciling", func() {
 				Expect(getObject(customCLFName, ds)).Should(Succeed())
 			})
 		})
+
+		Context("when exposing receiver inputs outside the cluster", func() {
+			const (
+				clfName   = "receivers"
+				httpName  = "webhook"
+				syslogLBN = "appliances"
+			)
+
+			fwder := runtime.NewClusterLogForwarder(constants.OpenshiftNS, clfName)
+			resourceNames := factory.GenerateResourceNames(*fwder)
+			vectorCABundle := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceNames.CaTrustBundle,
+					Namespace: cluster.GetNamespace(),
+					Labels: map[string]string{
+						constants.InjectTrustedCABundleLabel: "true",
+					},
+					OwnerReferences: []metav1.OwnerReference{utils.AsOwner(fwder)},
+				},
+				Data: map[string]string{
+					constants.TrustedCABundleKey: "",
+				},
+			}
+
+			var getObject = func(objName string, obj cli.Object) error {
+				nsName := types.NamespacedName{Name: objName, Namespace: cluster.GetNamespace()}
+				return client.Get(context.TODO(), nsName, obj)
+			}
+
+			BeforeEach(func() {
+				client = fake.NewFakeClient( //nolint
+					cluster,
+					vectorCABundle,
+					namespace,
+				)
+				cluster.Spec.Collection = &loggingv1.CollectionSpec{
+					Type: loggingv1.LogCollectionTypeVector,
+				}
+				fwder.Spec = loggingv1.ClusterLogForwarderSpec{
+					Inputs: []loggingv1.InputSpec{
+						{
+							Name: httpName,
+							Receiver: &loggingv1.ReceiverSpec{
+								Type: loggingv1.ReceiverTypeHttp,
+								ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{
+									HTTP: &loggingv1.HTTPReceiver{Port: 8443, Format: loggingv1.FormatKubeAPIAudit},
+								},
+								Exposure: &loggingv1.ReceiverExposure{
+									Type: loggingv1.ReceiverExposureRoute,
+									Host: "webhook.example.com",
+								},
+							},
+						},
+						{
+							Name: syslogLBN,
+							Receiver: &loggingv1.ReceiverSpec{
+								Type: loggingv1.ReceiverTypeSyslog,
+								ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{
+									Syslog: &loggingv1.SyslogReceiver{Port: 10514},
+								},
+								Exposure: &loggingv1.ReceiverExposure{
+									Type: loggingv1.ReceiverExposureLoadBalancer,
+								},
+							},
+						},
+					},
+					Outputs: []loggingv1.OutputSpec{
+						{
+							Name: "collector",
+							Type: loggingv1.OutputTypeHttp,
+							URL:  "http://collector.example.com",
+						},
+					},
+					Pipelines: []loggingv1.PipelineSpec{
+						{
+							InputRefs:  []string{httpName, syslogLBN},
+							OutputRefs: []string{"collector"},
+						},
+					},
+				}
+				fwder.Status = loggingv1.ClusterLogForwarderStatus{
+					Inputs: loggingv1.NamedConditions{
+						httpName:  loggingv1.NewConditions(loggingv1.CondReady),
+						syslogLBN: loggingv1.NewConditions(loggingv1.CondReady),
+					},
+				}
+				clusterRequest = &ClusterLoggingRequest{
+					Client:        client,
+					Reader:        client,
+					Cluster:       cluster,
+					EventRecorder: record.NewFakeRecorder(100),
+					Forwarder:     fwder,
+					ResourceNames: resourceNames,
+					ResourceOwner: utils.AsOwner(fwder),
+					isDaemonset:   true,
+				}
+			})
+
+			It("should reconcile the route and service types and report the addresses of the inputs", func() {
+				Expect(clusterRequest.CreateOrUpdateCollection()).To(Succeed())
+
+				route := &routev1.Route{}
+				Expect(getObject(resourceNames.GenerateInputServiceName(httpName), route)).To(Succeed())
+				Expect(route.Spec.Host).To(Equal("webhook.example.com"))
+				service := &corev1.Service{}
+				Expect(getObject(resourceNames.GenerateInputServiceName(syslogLBN), service)).To(Succeed())
+				Expect(service.Spec.Type).To(Equal(corev1.ServiceTypeLoadBalancer))
+
+				Expect(fwder.Status.Inputs[httpName]).To(HaveCondition(loggingv1.ConditionReady, true, loggingv1.ReasonExposed, "reachable at webhook.example.com:443"))
+				Expect(fwder.Status.Inputs[syslogLBN]).To(HaveCondition(loggingv1.ConditionReady, true, loggingv1.ReasonExposed, "waiting for the address of the LoadBalancer"))
+			})
+
+			It("should remove the route of an input no longer exposed by a route", func() {
+				Expect(clusterRequest.CreateOrUpdateCollection()).To(Succeed())
+				fwder.Spec.Inputs[0].Receiver.Exposure = nil
+				Expect(clusterRequest.RemoveInputServices([]metav1.OwnerReference{utils.AsOwner(fwder)}, false)).To(Succeed())
+
+				route := &routev1.Route{}
+				Expect(getObject(resourceNames.GenerateInputServiceName(httpName), route)).ToNot(Succeed())
+				Expect(getObject(resourceNames.GenerateInputServiceName(httpName), &corev1.Service{})).To(Succeed())
+			})
+		})
 	})
 })
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	routev1 "github.com/openshift/api/route/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
@@ -44,6 +45,27 @@ func (clusterRequest *ClusterLoggingRequest) RemoveInputService(serviceName stri
 	return nil
 }
 
+// RemoveInputRoute with given name and namespace
+func (clusterRequest *ClusterLoggingRequest) RemoveInputRoute(routeName string) error {
+	route := runtime.NewRoute(clusterRequest.Forwarder.Namespace, routeName, routeName, "")
+	err := clusterRequest.Delete(route)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("Failure deleting %v route %v", routeName, err)
+	}
+
+	return nil
+}
+
+// GetRouteList returns a list of routes based on a key/value label
+func (clusterRequest *ClusterLoggingRequest) GetRouteList(key, val, namespace string) (*routev1.RouteList, error) {
+	labelSelector, _ := labels.Parse(fmt.Sprintf("%s=%s", key, val))
+	routes := routev1.RouteList{}
+	if err := clusterRequest.Client.List(context.TODO(), &routes, &client.ListOptions{LabelSelector: labelSelector, Namespace: namespace}); err != nil {
+		return nil, fmt.Errorf("failure listing routes with label: %s,  %v", fmt.Sprintf("%s=%s", key, val), err)
+	}
+	return &routes, nil
+}
+
 // GetServiceList returns a list of services based on a key/value label
 func (clusterRequest *ClusterLoggingRequest) GetServiceList(key, val, namespace string) (*core.ServiceList, error) {
 	labelSelector, _ := labels.Parse(fmt.Sprintf("%s=%s", key, val))
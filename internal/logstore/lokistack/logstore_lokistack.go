@@ -251,12 +251,19 @@ func getInputTypeFromName(spec loggingv1.ClusterLogForwarderSpec, inputName stri
 			if input.Application != nil {
 				return loggingv1.InputNameApplication
 			}
-			if input.Infrastructure != nil || loggingv1.IsSyslogReceiver(&input) {
+			if input.Infrastructure != nil {
 				return loggingv1.InputNameInfrastructure
 			}
-			if input.Audit != nil || loggingv1.IsAuditHttpReceiver(&input) {
+			if input.Audit != nil {
 				return loggingv1.InputNameAudit
 			}
+			if input.Receiver != nil {
+				// Records of custom log types are stored in the application tenant
+				if logType := loggingv1.ReceiverLogType(&input); loggingv1.ReservedInputNames.Has(logType) {
+					return logType
+				}
+				return loggingv1.InputNameApplication
+			}
 		}
 	}
 	log.V(3).Info("unable to get input type from name", "inputName", inputName)
@@ -274,9 +281,19 @@ func lokiStackURL(logStore *loggingv1.LogStoreSpec, namespace, tenant string) st
 		log.V(3).Info("url tenant must be one of our reserved input names", "tenant", tenant)
 		return ""
 	}
+	return TenantURL(service, namespace, tenant)
+}
+
+// TenantURL returns the URL of the LokiStack API for a tenant given the name of the gateway service
+func TenantURL(service, namespace, tenant string) string {
 	return fmt.Sprintf("https://%s.%s.svc:8080/api/logs/v1/%s", service, namespace, tenant)
 }
 
+// GatewayServiceName returns the name of the gateway service of a LokiStack
+func GatewayServiceName(lokiStackName string) string {
+	return fmt.Sprintf("%s-gateway-http", lokiStackName)
+}
+
 // LokiStackGatewayService returns the name of LokiStack gateway service.
 // Returns an empty string if ClusterLogging is not configured for a LokiStack log store.
 func LokiStackGatewayService(logStore *loggingv1.LogStoreSpec) string {
@@ -284,7 +301,7 @@ func LokiStackGatewayService(logStore *loggingv1.LogStoreSpec) string {
 		return ""
 	}
 
-	return fmt.Sprintf("%s-gateway-http", logStore.LokiStack.Name)
+	return GatewayServiceName(logStore.LokiStack.Name)
 }
 
 // FormatOutputNameFromInput takes an clf.input and formats the output name for  'default' output
@@ -239,6 +239,49 @@ func TestProcessPipelinesForLokiStack(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "receiver of a custom log type - application tenant",
+			spec: loggingv1.ClusterLogForwarderSpec{
+				Inputs: []loggingv1.InputSpec{
+					{
+						Name: "functions",
+						Receiver: &loggingv1.ReceiverSpec{
+							Type: loggingv1.ReceiverTypeHttp,
+							ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{
+								HTTP: &loggingv1.HTTPReceiver{
+									Port:    8443,
+									Format:  loggingv1.FormatJSON,
+									LogType: "serverless",
+								},
+							},
+						},
+					},
+				},
+				Pipelines: []loggingv1.PipelineSpec{
+					{
+						OutputRefs: []string{loggingv1.OutputNameDefault},
+						InputRefs:  []string{"functions"},
+					},
+				},
+			},
+			wantOutputs: []loggingv1.OutputSpec{
+				{
+					Name: loggingv1.OutputNameDefault + "-functions",
+					Type: loggingv1.OutputTypeLoki,
+					URL:  "https://lokistack-testing-gateway-http.aNamespace.svc:8080/api/logs/v1/application",
+					Secret: &loggingv1.OutputSecretSpec{
+						Name: constants.LogCollectorToken,
+					},
+				},
+			},
+			wantPipelines: []loggingv1.PipelineSpec{
+				{
+					Name:       "default_loki_pipeline_0_",
+					OutputRefs: []string{loggingv1.OutputNameDefault + "-functions"},
+					InputRefs:  []string{"functions"},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -26,6 +26,10 @@ const (
 	OutputTypeHttp               = v1.OutputTypeHttp
 	OutputTypeGoogleCloudLogging = v1.OutputTypeGoogleCloudLogging
 	OutputTypeSplunk             = v1.OutputTypeSplunk
+	OutputTypeOTLP               = v1.OutputTypeOTLP
+	OutputTypeS3                 = v1.OutputTypeS3
+	OutputTypeAzureMonitor       = v1.OutputTypeAzureMonitor
+	OutputTypeLokiStack          = v1.OutputTypeLokiStack
 
 	ManagedStatus = "managedStatus"
 	HealthStatus  = "healthStatus"
@@ -63,6 +67,10 @@ func NewTD() *TData {
 			OutputTypeCloudwatch:         IsNotPresent,
 			OutputTypeHttp:               IsNotPresent,
 			OutputTypeSplunk:             IsNotPresent,
+			OutputTypeOTLP:               IsNotPresent,
+			OutputTypeS3:                 IsNotPresent,
+			OutputTypeAzureMonitor:       IsNotPresent,
+			OutputTypeLokiStack:          IsNotPresent,
 			OutputTypeGoogleCloudLogging: IsNotPresent}),
 		LFMEInfo: utils.InitStringMap(map[string]string{Deployed: IsNotPresent, HealthStatus: IsNotPresent}),
 	}
@@ -106,6 +114,10 @@ var (
 			OutputTypeCloudwatch,
 			OutputTypeHttp,
 			OutputTypeSplunk,
+			OutputTypeOTLP,
+			OutputTypeS3,
+			OutputTypeAzureMonitor,
+			OutputTypeLokiStack,
 			OutputTypeGoogleCloudLogging},
 	)
 
@@ -197,6 +209,10 @@ func SetCLFMetrics(value float64) {
 		OutputTypeCloudwatch:         CLFOutputType.Get(OutputTypeCloudwatch),
 		OutputTypeHttp:               CLFOutputType.Get(OutputTypeHttp),
 		OutputTypeSplunk:             CLFOutputType.Get(OutputTypeSplunk),
+		OutputTypeOTLP:               CLFOutputType.Get(OutputTypeOTLP),
+		OutputTypeS3:                 CLFOutputType.Get(OutputTypeS3),
+		OutputTypeAzureMonitor:       CLFOutputType.Get(OutputTypeAzureMonitor),
+		OutputTypeLokiStack:          CLFOutputType.Get(OutputTypeLokiStack),
 		OutputTypeGoogleCloudLogging: CLFOutputType.Get(OutputTypeGoogleCloudLogging)}).Set(value)
 }
 
@@ -18,7 +18,7 @@ var (
 
 // DropUnreferencedOutputs removes unreferenced outputs from ClusterLogForwarder to support backwards compatibility with
 // previous versions that handled this scenario gracefully
-func DropUnreferencedOutputs(namespace, name string, spec loggingv1.ClusterLogForwarderSpec, logStore *loggingv1.LogStoreSpec, extras map[string]bool, logstoreSecretName, saTokenSecret string) (loggingv1.ClusterLogForwarderSpec, map[string]bool, []loggingv1.Condition) {
+func DropUnreferencedOutputs(namespace, name string, spec loggingv1.ClusterLogForwarderSpec, logStore *loggingv1.LogStoreSpec, extras map[string]bool, logstoreSecretName, saTokenSecret string, secrets map[string]*corev1.Secret) (loggingv1.ClusterLogForwarderSpec, map[string]bool, []loggingv1.Condition) {
 	warnings := []loggingv1.Condition{}
 	outputRefs := sets.NewString()
 	for _, p := range spec.Pipelines {
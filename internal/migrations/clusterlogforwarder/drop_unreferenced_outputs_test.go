@@ -26,7 +26,7 @@ var _ = Describe("DropUnreferencedOutputs", func() {
 	})
 
 	It("should drop outputs that are not referenced by any pipeline", func() {
-		result, _, conditions := DropUnreferencedOutputs("", "", spec, nil, nil, "", "")
+		result, _, conditions := DropUnreferencedOutputs("", "", spec, nil, nil, "", "", nil)
 		Expect(result).To(Equal(loggingv1.ClusterLogForwarderSpec{
 			Outputs: []loggingv1.OutputSpec{
 				{Name: "foo"},
@@ -42,7 +42,7 @@ var _ = Describe("DropUnreferencedOutputs", func() {
 		spec.Pipelines = append(spec.Pipelines, loggingv1.PipelineSpec{
 			OutputRefs: []string{"dropme"},
 		})
-		result, _, conditions := DropUnreferencedOutputs("", "", spec, nil, nil, "", "")
+		result, _, conditions := DropUnreferencedOutputs("", "", spec, nil, nil, "", "", nil)
 		Expect(result).To(Equal(loggingv1.ClusterLogForwarderSpec{
 			Outputs: []loggingv1.OutputSpec{
 				{Name: "dropme"},
@@ -6,9 +6,10 @@ import (
 	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/logstore/lokistack"
+	corev1 "k8s.io/api/core/v1"
 )
 
-func MigrateClusterLogForwarderSpec(namespace, name string, spec loggingv1.ClusterLogForwarderSpec, logStore *loggingv1.LogStoreSpec, extras map[string]bool, logstoreSecretName, saTokenSecret string) (loggingv1.ClusterLogForwarderSpec, map[string]bool, []loggingv1.Condition) {
+func MigrateClusterLogForwarderSpec(namespace, name string, spec loggingv1.ClusterLogForwarderSpec, logStore *loggingv1.LogStoreSpec, extras map[string]bool, logstoreSecretName, saTokenSecret string, secrets map[string]*corev1.Secret) (loggingv1.ClusterLogForwarderSpec, map[string]bool, []loggingv1.Condition) {
 	spec, extras = migrateDefaultOutput(spec, logStore, extras, logstoreSecretName, saTokenSecret)
 	if namespace == constants.OpenshiftNS && name == constants.SingletonName {
 		spec.ServiceAccountName = constants.CollectorServiceAccountName
@@ -36,7 +36,7 @@ var _ = Describe("MigrateClusterLogForwarderSpec", func() {
 				},
 			}
 
-			out, _, _ := MigrateClusterLogForwarderSpec("test-ns", "test-clf", in_spec, nil, map[string]bool{}, constants.CollectorName, constants.LogCollectorToken)
+			out, _, _ := MigrateClusterLogForwarderSpec("test-ns", "test-clf", in_spec, nil, map[string]bool{}, constants.CollectorName, constants.LogCollectorToken, nil)
 			for i, pipeline := range out.Pipelines {
 				Expect(pipeline.Name).To(Equal(fmt.Sprintf("pipeline_%v", i)))
 			}
@@ -83,14 +83,14 @@ var _ = Describe("MigrateClusterLogForwarderSpec", func() {
 		})
 
 		It("should not add service account name if forwarder not named `instance` and not in `openshift-logging` namespace", func() {
-			forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec("test-ns", "test-clf", spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken)
+			forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec("test-ns", "test-clf", spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken, nil)
 			Expect(forwarderSpec).To(Equal(spec))
 			Expect(extras).To(Equal(map[string]bool{}))
 		})
 
 		It("should not add the default OutputSpec when it is not referenced by a pipeline", func() {
 			// This is equal to returning (spec, nil) and will only pass if 2nd param is nil
-			forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken)
+			forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken, nil)
 			spec.ServiceAccountName = constants.CollectorServiceAccountName
 			Expect(forwarderSpec).To(Equal(spec))
 			Expect(extras).To(Equal(map[string]bool{}))
@@ -98,7 +98,7 @@ var _ = Describe("MigrateClusterLogForwarderSpec", func() {
 
 		It("should add the default OutputSpec when default logstore exists and spec is empty ", func() {
 			logstore = &logging.LogStoreSpec{Type: logging.OutputTypeElasticsearch}
-			forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, logging.ClusterLogForwarderSpec{}, logstore, extras, constants.CollectorName, constants.LogCollectorToken)
+			forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, logging.ClusterLogForwarderSpec{}, logstore, extras, constants.CollectorName, constants.LogCollectorToken, nil)
 			Expect(forwarderSpec).To(Equal(
 				logging.ClusterLogForwarderSpec{
 					Pipelines: []logging.PipelineSpec{
@@ -123,7 +123,7 @@ var _ = Describe("MigrateClusterLogForwarderSpec", func() {
 				},
 			}
 
-			spec, extras, _ = MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, logging.ClusterLogForwarderSpec{}, logstore, extras, constants.CollectorName, constants.LogCollectorToken)
+			spec, extras, _ = MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, logging.ClusterLogForwarderSpec{}, logstore, extras, constants.CollectorName, constants.LogCollectorToken, nil)
 
 			Expect(spec).To(Equal(
 				logging.ClusterLogForwarderSpec{
@@ -179,7 +179,7 @@ var _ = Describe("MigrateClusterLogForwarderSpec", func() {
 				},
 			}
 
-			spec, extras, _ = MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken)
+			spec, extras, _ = MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken, nil)
 
 			Expect(spec).To(Equal(
 				logging.ClusterLogForwarderSpec{
@@ -226,7 +226,7 @@ var _ = Describe("MigrateClusterLogForwarderSpec", func() {
 				})
 
 				It("should add the default OutputSpec", func() {
-					forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken)
+					forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken, nil)
 					Expect(forwarderSpec).To(Equal(exp), fmt.Sprintf("Exp. default output because of pipeline %v", pipelines))
 					Expect(extras).To(Equal(map[string]bool{constants.MigrateDefaultOutput: true}))
 				})
@@ -240,7 +240,7 @@ var _ = Describe("MigrateClusterLogForwarderSpec", func() {
 					exp.Outputs[1].Elasticsearch = &logging.Elasticsearch{ElasticsearchStructuredSpec: *spec.OutputDefaults.Elasticsearch}
 					exp.OutputDefaults = spec.OutputDefaults
 
-					forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken)
+					forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken, nil)
 
 					Expect(forwarderSpec).To(Equal(exp), fmt.Sprintf("Exp. default output because of pipeline %v and OutputDefault %v", pipelines, spec.OutputDefaults))
 					Expect(extras).To(Equal(map[string]bool{constants.MigrateDefaultOutput: true}))
@@ -268,7 +268,7 @@ var _ = Describe("MigrateClusterLogForwarderSpec", func() {
 					exp.Outputs = append(outputs, NewDefaultOutput(nil, constants.CollectorName))
 					exp.ServiceAccountName = constants.CollectorServiceAccountName
 
-					forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken)
+					forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken, nil)
 					Expect(forwarderSpec).To(Equal(exp), fmt.Sprintf("Exp. default output because of pipeline %v", pipelines))
 					Expect(extras).To(Equal(map[string]bool{constants.MigrateDefaultOutput: true}))
 				})
@@ -284,7 +284,7 @@ var _ = Describe("MigrateClusterLogForwarderSpec", func() {
 					exp.Outputs = append(outputs, NewDefaultOutput(&logging.OutputDefaults{Elasticsearch: &esSpec.ElasticsearchStructuredSpec}, constants.CollectorName))
 					exp.ServiceAccountName = constants.CollectorServiceAccountName
 
-					forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken)
+					forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken, nil)
 					Expect(forwarderSpec).To(Equal(exp), fmt.Sprintf("Exp. default output because of pipeline %v and ElasticsearchSpec %v", pipelines, esSpec))
 					Expect(extras).To(Equal(map[string]bool{constants.MigrateDefaultOutput: true}))
 				})
@@ -311,7 +311,7 @@ var _ = Describe("MigrateClusterLogForwarderSpec", func() {
 				})
 
 				It("should add the default OutputSpec", func() {
-					forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken)
+					forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken, nil)
 					Expect(forwarderSpec).To(Equal(exp), fmt.Sprintf("Exp. default output because of pipeline %v", pipelines))
 					Expect(extras).To(Equal(map[string]bool{constants.MigrateDefaultOutput: true}))
 				})
@@ -324,7 +324,7 @@ var _ = Describe("MigrateClusterLogForwarderSpec", func() {
 					exp.Outputs[1].Elasticsearch = &logging.Elasticsearch{ElasticsearchStructuredSpec: *spec.OutputDefaults.Elasticsearch}
 					exp.OutputDefaults = spec.OutputDefaults
 
-					forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken)
+					forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken, nil)
 
 					Expect(forwarderSpec).To(Equal(exp), fmt.Sprintf("Exp. default output because of pipeline %v and OutputDefault %v", pipelines, spec.OutputDefaults))
 					Expect(extras).To(Equal(map[string]bool{constants.MigrateDefaultOutput: true}))
@@ -352,7 +352,7 @@ var _ = Describe("MigrateClusterLogForwarderSpec", func() {
 					exp.Outputs = append(outputs, NewDefaultOutput(nil, constants.CollectorName))
 					exp.ServiceAccountName = constants.CollectorServiceAccountName
 
-					forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken)
+					forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken, nil)
 					Expect(forwarderSpec).To(Equal(exp), fmt.Sprintf("Exp. default output because of pipeline %v", pipelines))
 					Expect(extras).To(Equal(map[string]bool{constants.MigrateDefaultOutput: true}))
 				})
@@ -368,7 +368,7 @@ var _ = Describe("MigrateClusterLogForwarderSpec", func() {
 					exp.Outputs = append(outputs, NewDefaultOutput(&logging.OutputDefaults{Elasticsearch: &esSpec.ElasticsearchStructuredSpec}, constants.CollectorName))
 					exp.ServiceAccountName = constants.CollectorServiceAccountName
 
-					forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken)
+					forwarderSpec, extras, _ := MigrateClusterLogForwarderSpec(constants.OpenshiftNS, constants.SingletonName, spec, logstore, extras, constants.CollectorName, constants.LogCollectorToken, nil)
 					Expect(forwarderSpec).To(Equal(exp), fmt.Sprintf("Exp. default output because of pipeline %v and ElasticsearchSpec %v", pipelines, esSpec))
 					Expect(extras).To(Equal(map[string]bool{constants.MigrateDefaultOutput: true}))
 				})
@@ -3,9 +3,10 @@ package clusterlogforwarder
 import (
 	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
+	corev1 "k8s.io/api/core/v1"
 )
 
-func MigrateInputs(namespace, name string, spec loggingv1.ClusterLogForwarderSpec, logStore *loggingv1.LogStoreSpec, extras map[string]bool, logstoreSecretName, saTokenSecret string) (loggingv1.ClusterLogForwarderSpec, map[string]bool, []loggingv1.Condition) {
+func MigrateInputs(namespace, name string, spec loggingv1.ClusterLogForwarderSpec, logStore *loggingv1.LogStoreSpec, extras map[string]bool, logstoreSecretName, saTokenSecret string, secrets map[string]*corev1.Secret) (loggingv1.ClusterLogForwarderSpec, map[string]bool, []loggingv1.Condition) {
 	inputs := map[string]loggingv1.InputSpec{}
 	for _, p := range spec.Pipelines {
 		for _, i := range p.InputRefs {
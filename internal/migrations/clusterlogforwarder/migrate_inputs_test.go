@@ -17,7 +17,7 @@ var _ = Describe("migrateInputs", func() {
 				},
 			}
 			extras := map[string]bool{}
-			result, _, _ := MigrateInputs("", "", spec, nil, extras, "", "")
+			result, _, _ := MigrateInputs("", "", spec, nil, extras, "", "", nil)
 			Expect(result.Inputs).To(HaveLen(1))
 			Expect(result.Inputs[0]).To(Equal(logging.InputSpec{Name: logging.InputNameApplication, Application: &logging.Application{}}))
 			Expect(extras).To(Equal(map[string]bool{constants.MigrateInputApplication: true}))
@@ -29,7 +29,7 @@ var _ = Describe("migrateInputs", func() {
 				},
 			}
 			extras := map[string]bool{}
-			result, _, _ := MigrateInputs("", "", spec, nil, extras, "", "")
+			result, _, _ := MigrateInputs("", "", spec, nil, extras, "", "", nil)
 			Expect(result.Inputs).To(HaveLen(1))
 			Expect(result.Inputs[0]).To(Equal(logging.InputSpec{Name: logging.InputNameInfrastructure,
 				Infrastructure: &logging.Infrastructure{
@@ -44,7 +44,7 @@ var _ = Describe("migrateInputs", func() {
 				},
 			}
 			extras := map[string]bool{}
-			result, _, _ := MigrateInputs("", "", spec, nil, extras, "", "")
+			result, _, _ := MigrateInputs("", "", spec, nil, extras, "", "", nil)
 			Expect(result.Inputs).To(HaveLen(1))
 			Expect(result.Inputs[0]).To(Equal(logging.InputSpec{Name: logging.InputNameAudit,
 				Audit: &logging.Audit{
@@ -0,0 +1,48 @@
+package clusterlogforwarder
+
+import (
+	"strings"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// MigrateKafkaSASL sets the SASL spec of Kafka outputs whose secret enables SASL using the deprecated
+// `sasl.enable` or `sasl_over_ssl` keys and optionally `sasl.mechanisms`. Outputs that already
+// spec SASL are not modified. Secrets are keyed by output name
+func MigrateKafkaSASL(namespace, name string, spec loggingv1.ClusterLogForwarderSpec, logStore *loggingv1.LogStoreSpec, extras map[string]bool, logstoreSecretName, saTokenSecret string, secrets map[string]*corev1.Secret) (loggingv1.ClusterLogForwarderSpec, map[string]bool, []loggingv1.Condition) {
+	outputs := make([]loggingv1.OutputSpec, 0, len(spec.Outputs))
+	for _, output := range spec.Outputs {
+		if output.Type == loggingv1.OutputTypeKafka && (output.Kafka == nil || output.Kafka.SASL == nil) {
+			if sasl := legacyKafkaSASL(secrets[output.Name]); sasl != nil {
+				log.V(3).Info("Migrating deprecated SASL secret keys", "output", output.Name)
+				kafka := loggingv1.Kafka{}
+				if output.Kafka != nil {
+					kafka = *output.Kafka
+				}
+				kafka.SASL = sasl
+				output.Kafka = &kafka
+			}
+		}
+		outputs = append(outputs, output)
+	}
+	spec.Outputs = outputs
+	return spec, extras, nil
+}
+
+func legacyKafkaSASL(secret *corev1.Secret) *loggingv1.KafkaSASL {
+	if secret == nil {
+		return nil
+	}
+	_, enabled := secret.Data[constants.SASLEnable]
+	_, overSSL := secret.Data[constants.DeprecatedSaslOverSSL]
+	if !enabled && !overSSL {
+		return nil
+	}
+	// librdkafka does not support multiple values for sasl mechanism, a list is rejected by validation
+	return &loggingv1.KafkaSASL{
+		Mechanism: strings.TrimSpace(string(secret.Data[constants.SASLMechanisms])),
+	}
+}
@@ -0,0 +1,54 @@
+package clusterlogforwarder
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("MigrateKafkaSASL", func() {
+
+	newSpec := func(kafka *loggingv1.Kafka) loggingv1.ClusterLogForwarderSpec {
+		return loggingv1.ClusterLogForwarderSpec{
+			Outputs: []loggingv1.OutputSpec{
+				{
+					Name:           "kafka",
+					Type:           loggingv1.OutputTypeKafka,
+					Secret:         &loggingv1.OutputSecretSpec{Name: "kafka-secret"},
+					OutputTypeSpec: loggingv1.OutputTypeSpec{Kafka: kafka},
+				},
+			},
+		}
+	}
+	newSecrets := func(data map[string][]byte) map[string]*corev1.Secret {
+		return map[string]*corev1.Secret{
+			"kafka": runtime.NewSecret("openshift-logging", "kafka-secret", data),
+		}
+	}
+
+	DescribeTable("should migrate the deprecated secret keys", func(data map[string][]byte, kafka *loggingv1.Kafka, exp *loggingv1.Kafka) {
+		spec := newSpec(kafka)
+		result, _, _ := MigrateKafkaSASL("openshift-logging", "instance", spec, nil, nil, "", "", newSecrets(data))
+		Expect(result.Outputs[0].Kafka).To(Equal(exp))
+		Expect(spec.Outputs[0].Kafka).To(Equal(kafka), "should not modify the original spec")
+	},
+		Entry("with sasl.enable", map[string][]byte{"sasl.enable": []byte("true")}, nil,
+			&loggingv1.Kafka{SASL: &loggingv1.KafkaSASL{}}),
+		Entry("with sasl_over_ssl and sasl.mechanisms", map[string][]byte{"sasl_over_ssl": []byte("true"), "sasl.mechanisms": []byte("SCRAM-SHA-256")},
+			&loggingv1.Kafka{Topic: "logs"},
+			&loggingv1.Kafka{Topic: "logs", SASL: &loggingv1.KafkaSASL{Mechanism: "SCRAM-SHA-256"}}),
+		Entry("without SASL keys", map[string][]byte{"username": []byte("user")}, nil, nil),
+		Entry("when SASL is already spec'd", map[string][]byte{"sasl.enable": []byte("true"), "sasl.mechanisms": []byte("SCRAM-SHA-256")},
+			&loggingv1.Kafka{SASL: &loggingv1.KafkaSASL{Mechanism: "SCRAM-SHA-512"}},
+			&loggingv1.Kafka{SASL: &loggingv1.KafkaSASL{Mechanism: "SCRAM-SHA-512"}}),
+	)
+
+	It("should ignore outputs without a secret", func() {
+		spec := newSpec(nil)
+		result, _, _ := MigrateKafkaSASL("openshift-logging", "instance", spec, nil, nil, "", "", map[string]*corev1.Secret{})
+		Expect(result).To(Equal(spec))
+	})
+})
@@ -0,0 +1,23 @@
+package clusterlogforwarder
+
+import (
+	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// MigrateLokiStackOutputs defaults the namespace of LokiStack outputs to the namespace of the ClusterLogForwarder
+func MigrateLokiStackOutputs(namespace, name string, spec loggingv1.ClusterLogForwarderSpec, logStore *loggingv1.LogStoreSpec, extras map[string]bool, logstoreSecretName, saTokenSecret string, secrets map[string]*corev1.Secret) (loggingv1.ClusterLogForwarderSpec, map[string]bool, []loggingv1.Condition) {
+	outputs := make([]loggingv1.OutputSpec, 0, len(spec.Outputs))
+	for _, output := range spec.Outputs {
+		if output.Type == loggingv1.OutputTypeLokiStack && output.LokiStack != nil {
+			lokiStack := *output.LokiStack
+			if lokiStack.Namespace == "" {
+				lokiStack.Namespace = namespace
+			}
+			output.LokiStack = &lokiStack
+		}
+		outputs = append(outputs, output)
+	}
+	spec.Outputs = outputs
+	return spec, extras, nil
+}
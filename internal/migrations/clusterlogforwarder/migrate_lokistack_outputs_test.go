@@ -0,0 +1,48 @@
+package clusterlogforwarder
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+)
+
+var _ = Describe("MigrateLokiStackOutputs", func() {
+
+	newSpec := func(output loggingv1.OutputSpec) loggingv1.ClusterLogForwarderSpec {
+		return loggingv1.ClusterLogForwarderSpec{Outputs: []loggingv1.OutputSpec{output}}
+	}
+
+	It("should default the namespace", func() {
+		spec := newSpec(loggingv1.OutputSpec{
+			Name: "lokistack",
+			Type: loggingv1.OutputTypeLokiStack,
+			OutputTypeSpec: loggingv1.OutputTypeSpec{
+				LokiStack: &loggingv1.LokiStack{Name: "logging-loki"},
+			},
+		})
+		result, _, conditions := MigrateLokiStackOutputs("my-ns", "my-forwarder", spec, nil, map[string]bool{}, "", "my-forwarder-token", nil)
+		Expect(conditions).To(BeEmpty())
+		Expect(result.Outputs[0].LokiStack).To(Equal(&loggingv1.LokiStack{Name: "logging-loki", Namespace: "my-ns"}))
+		Expect(result.Outputs[0].Secret).To(BeNil(), "should authenticate with a projected service account token")
+		Expect(spec.Outputs[0].LokiStack.Namespace).To(BeEmpty(), "should not modify the original spec")
+	})
+
+	It("should keep the namespace and the secret when spec'd", func() {
+		spec := newSpec(loggingv1.OutputSpec{
+			Name: "lokistack",
+			Type: loggingv1.OutputTypeLokiStack,
+			OutputTypeSpec: loggingv1.OutputTypeSpec{
+				LokiStack: &loggingv1.LokiStack{Name: "logging-loki", Namespace: "loki"},
+			},
+			Secret: &loggingv1.OutputSecretSpec{Name: "lokistack-token"},
+		})
+		result, _, _ := MigrateLokiStackOutputs("my-ns", "my-forwarder", spec, nil, map[string]bool{}, "", "my-forwarder-token", nil)
+		Expect(result).To(Equal(spec))
+	})
+
+	It("should ignore other output types", func() {
+		spec := newSpec(loggingv1.OutputSpec{Name: "loki", Type: loggingv1.OutputTypeLoki, URL: "https://loki.svc:3100"})
+		result, _, _ := MigrateLokiStackOutputs("my-ns", "my-forwarder", spec, nil, map[string]bool{}, "", "my-forwarder-token", nil)
+		Expect(result).To(Equal(spec))
+	})
+})
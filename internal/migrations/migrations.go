@@ -4,6 +4,7 @@ import (
 	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
 	"github.com/openshift/cluster-logging-operator/internal/migrations/clusterlogforwarder"
 	"github.com/openshift/cluster-logging-operator/internal/migrations/clusterlogging"
+	corev1 "k8s.io/api/core/v1"
 )
 
 func MigrateClusterLogging(spec loggingv1.ClusterLoggingSpec) (loggingv1.ClusterLoggingSpec, []loggingv1.Condition) {
@@ -23,19 +24,23 @@ var clMigrations = []func(cl loggingv1.ClusterLoggingSpec) (loggingv1.ClusterLog
 	clusterlogging.MigrateVisualizationSpec,
 }
 
-func MigrateClusterLogForwarder(namespace, name string, spec loggingv1.ClusterLogForwarderSpec, logStore *loggingv1.LogStoreSpec, extras map[string]bool, logstoreSecretName, saTokenSecret string) (loggingv1.ClusterLogForwarderSpec, map[string]bool, []loggingv1.Condition) {
+// MigrateClusterLogForwarder migrates the spec of a ClusterLogForwarder. The secrets of the outputs, keyed by
+// output name, are used to migrate deprecated secret keys
+func MigrateClusterLogForwarder(namespace, name string, spec loggingv1.ClusterLogForwarderSpec, logStore *loggingv1.LogStoreSpec, extras map[string]bool, logstoreSecretName, saTokenSecret string, secrets map[string]*corev1.Secret) (loggingv1.ClusterLogForwarderSpec, map[string]bool, []loggingv1.Condition) {
 	conditions := []loggingv1.Condition{}
 	for _, migrate := range clfMigrations {
 		var migrationConditions []loggingv1.Condition
-		spec, extras, migrationConditions = migrate(namespace, name, spec, logStore, extras, logstoreSecretName, saTokenSecret)
+		spec, extras, migrationConditions = migrate(namespace, name, spec, logStore, extras, logstoreSecretName, saTokenSecret, secrets)
 		conditions = append(conditions, migrationConditions...)
 	}
 	return spec, extras, conditions
 }
 
 // migrations are the set of rules for migrating a ClusterLogForwarder that modify the spec
-var clfMigrations = []func(namespace, name string, spec loggingv1.ClusterLogForwarderSpec, logStore *loggingv1.LogStoreSpec, extras map[string]bool, logstoreSecretName, saTokenSecret string) (loggingv1.ClusterLogForwarderSpec, map[string]bool, []loggingv1.Condition){
+var clfMigrations = []func(namespace, name string, spec loggingv1.ClusterLogForwarderSpec, logStore *loggingv1.LogStoreSpec, extras map[string]bool, logstoreSecretName, saTokenSecret string, secrets map[string]*corev1.Secret) (loggingv1.ClusterLogForwarderSpec, map[string]bool, []loggingv1.Condition){
 	clusterlogforwarder.MigrateClusterLogForwarderSpec,
 	clusterlogforwarder.MigrateInputs,
+	clusterlogforwarder.MigrateLokiStackOutputs,
+	clusterlogforwarder.MigrateKafkaSASL,
 	clusterlogforwarder.DropUnreferencedOutputs,
 }
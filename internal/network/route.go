@@ -0,0 +1,82 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	routev1 "github.com/openshift/api/route/v1"
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/reconcile"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// routePort is the port of the router that serves passthrough routes
+const routePort = 443
+
+// ReconcileInputRoute reconciles the passthrough route that exposes the service of a TLS receiver input outside the cluster
+func ReconcileInputRoute(er record.EventRecorder, k8sClient client.Client, namespace, name, host string, targetPort int32, receiverType string, owner metav1.OwnerReference, visitors func(o runtime.Object)) error {
+	desired := runtime.NewRoute(namespace, name, name, strconv.Itoa(int(targetPort)))
+	visitors(desired)
+	desired.Labels[constants.LabelComponent] = inputServiceComponent(receiverType)
+	desired.Spec.Host = host
+	desired.Spec.TLS = &routev1.TLSConfig{
+		Termination: routev1.TLSTerminationPassthrough,
+	}
+
+	utils.AddOwnerRefToObject(desired, owner)
+	return reconcile.Route(er, k8sClient, desired)
+}
+
+// InputAddress returns the address a receiver input of the given exposure type is reachable at outside the cluster,
+// or empty if it is not reachable or the address is not yet assigned
+func InputAddress(k8sClient client.Client, namespace, name, exposureType string) (string, error) {
+	key := types.NamespacedName{Namespace: namespace, Name: name}
+	switch exposureType {
+	case logging.ReceiverExposureNodePort, logging.ReceiverExposureLoadBalancer:
+		service := &v1.Service{}
+		if err := k8sClient.Get(context.TODO(), key, service); err != nil {
+			return "", fmt.Errorf("failed to get %v Service: %w", key, err)
+		}
+		if len(service.Spec.Ports) == 0 {
+			return "", nil
+		}
+		port := service.Spec.Ports[0]
+		if exposureType == logging.ReceiverExposureNodePort {
+			if port.NodePort == 0 {
+				return "", nil
+			}
+			return fmt.Sprintf("node port %d", port.NodePort), nil
+		}
+		for _, ingress := range service.Status.LoadBalancer.Ingress {
+			host := ingress.Hostname
+			if host == "" {
+				host = ingress.IP
+			}
+			if host != "" {
+				return net.JoinHostPort(host, strconv.Itoa(int(port.Port))), nil
+			}
+		}
+	case logging.ReceiverExposureRoute:
+		route := &routev1.Route{}
+		if err := k8sClient.Get(context.TODO(), key, route); err != nil {
+			return "", fmt.Errorf("failed to get %v Route: %w", key, err)
+		}
+		host := route.Spec.Host
+		if host == "" && len(route.Status.Ingress) > 0 {
+			host = route.Status.Ingress[0].Host
+		}
+		if host != "" {
+			return net.JoinHostPort(host, strconv.Itoa(routePort)), nil
+		}
+	}
+	return "", nil
+}
@@ -38,7 +38,8 @@ func ReconcileService(er record.EventRecorder, k8sClient client.Client, namespac
 	return reconcile.Service(er, k8sClient, desired)
 }
 
-func ReconcileInputService(er record.EventRecorder, k8sClient client.Client, namespace, name, instance, certSecretName string, port int32, targetPort int32, receiverType string, isDaemonset bool, owner metav1.OwnerReference, visitors func(o runtime.Object)) error {
+// ReconcileInputService reconciles the service of the given type that exposes the port of a receiver input using the given protocol
+func ReconcileInputService(er record.EventRecorder, k8sClient client.Client, namespace, name, instance, certSecretName string, port int32, targetPort int32, protocol v1.Protocol, serviceType v1.ServiceType, receiverType string, isDaemonset bool, owner metav1.OwnerReference, visitors func(o runtime.Object)) error {
 	desired := factory.NewService(
 		name,
 		namespace,
@@ -51,11 +52,12 @@ func ReconcileInputService(er record.EventRecorder, k8sClient client.Client, nam
 					Type:   intstr.Int,
 					IntVal: targetPort,
 				},
-				Protocol: v1.ProtocolTCP,
+				Protocol: protocol,
 			},
 		},
 		visitors,
 	)
+	desired.Spec.Type = serviceType
 
 	if !isDaemonset {
 		desired.Spec.Selector[constants.CollectorDeploymentKind] = constants.DeploymentType
@@ -65,13 +67,16 @@ func ReconcileInputService(er record.EventRecorder, k8sClient client.Client, nam
 		constants.AnnotationServingCertSecretName: certSecretName,
 	}
 
-	switch receiverType {
-	case logging.ReceiverTypeHttp:
-		desired.Labels[constants.LabelComponent] = constants.LabelHTTPInputService
-	case logging.ReceiverTypeSyslog:
-		desired.Labels[constants.LabelComponent] = constants.LabelSyslogInputService
-	}
+	desired.Labels[constants.LabelComponent] = inputServiceComponent(receiverType)
 
 	utils.AddOwnerRefToObject(desired, owner)
 	return reconcile.Service(er, k8sClient, desired)
 }
+
+// inputServiceComponent returns the component label of the objects exposing a receiver input of the given type
+func inputServiceComponent(receiverType string) string {
+	if receiverType == logging.ReceiverTypeSyslog {
+		return constants.LabelSyslogInputService
+	}
+	return constants.LabelHTTPInputService
+}
@@ -6,6 +6,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	routev1 "github.com/openshift/api/route/v1"
 	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
@@ -94,4 +95,60 @@ var _ = Describe("Reconcile Service", func() {
 			To(Equal(certSecret))
 	})
 
+	It("should successfully reconcile the input service with the protocol of the receiver", func() {
+		Expect(ReconcileInputService(recorder,
+			reqClient,
+			constants.OpenshiftNS,
+			serviceName,
+			componentName,
+			certSecret,
+			port,
+			port,
+			corev1.ProtocolUDP,
+			corev1.ServiceTypeNodePort,
+			loggingv1.ReceiverTypeSyslog,
+			true,
+			owner,
+			commonLabels)).To(Succeed())
+
+		Expect(reqClient.Get(context.TODO(), serviceKey, serviceInstance)).Should(Succeed())
+		Expect(serviceInstance.Spec.Ports).To(HaveLen(1))
+		Expect(serviceInstance.Spec.Ports[0].Port).To(Equal(port))
+		Expect(serviceInstance.Spec.Ports[0].Protocol).To(Equal(corev1.ProtocolUDP))
+		Expect(serviceInstance.Spec.Type).To(Equal(corev1.ServiceTypeNodePort))
+		Expect(serviceInstance.Labels[constants.LabelComponent]).To(Equal(constants.LabelSyslogInputService))
+	})
+
+	It("should successfully reconcile the passthrough route of the input service", func() {
+		Expect(ReconcileInputRoute(recorder,
+			reqClient,
+			constants.OpenshiftNS,
+			serviceName,
+			"logs.example.com",
+			port,
+			loggingv1.ReceiverTypeHttp,
+			owner,
+			commonLabels)).To(Succeed())
+
+		route := &routev1.Route{}
+		Expect(reqClient.Get(context.TODO(), serviceKey, route)).Should(Succeed())
+		Expect(route.Spec.To.Name).To(Equal(serviceName))
+		Expect(route.Spec.Port.TargetPort.IntVal).To(Equal(port))
+		Expect(route.Spec.TLS.Termination).To(Equal(routev1.TLSTerminationPassthrough))
+		Expect(route.Labels[constants.LabelComponent]).To(Equal(constants.LabelHTTPInputService))
+
+		Expect(InputAddress(reqClient, constants.OpenshiftNS, serviceName, loggingv1.ReceiverExposureRoute)).
+			To(Equal("logs.example.com:443"))
+	})
+
+	It("should report the address of the load balancer of the input service once assigned", func() {
+		Expect(InputAddress(reqClient, constants.OpenshiftNS, serviceName, loggingv1.ReceiverExposureLoadBalancer)).To(BeEmpty())
+
+		Expect(reqClient.Get(context.TODO(), serviceKey, serviceInstance)).Should(Succeed())
+		serviceInstance.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "192.0.2.10"}}
+		Expect(reqClient.Status().Update(context.TODO(), serviceInstance)).To(Succeed())
+		Expect(InputAddress(reqClient, constants.OpenshiftNS, serviceName, loggingv1.ReceiverExposureLoadBalancer)).
+			To(Equal("192.0.2.10:1337"))
+	})
+
 })
@@ -60,11 +60,13 @@ func Generate(collectionType logging.LogCollectionType, clfYaml string, includeD
 		}
 	}
 
-	mSpec, extras, condition := migrations.MigrateClusterLogForwarder(forwarder.Namespace, forwarder.Name, forwarder.Spec, clRequest.Cluster.Spec.LogStore, map[string]bool{}, "", "")
-	log.V(0).Info("Migrated ClusterLogForwarder", "spec", mSpec, "extras", extras, "condition", condition)
-	// Set the output secrets if any
+	// The output secrets are needed to migrate deprecated secret keys
 	clRequest.SetOutputSecrets()
+	mSpec, extras, condition := migrations.MigrateClusterLogForwarder(forwarder.Namespace, forwarder.Name, forwarder.Spec, clRequest.Cluster.Spec.LogStore, map[string]bool{}, "", "", clRequest.OutputSecrets)
+	log.V(0).Info("Migrated ClusterLogForwarder", "spec", mSpec, "extras", extras, "condition", condition)
 	forwarder.Spec = mSpec
+	// Set the output secrets if any, including the ones of migrated outputs
+	clRequest.SetOutputSecrets()
 	tunings := &logging.FluentdForwarderSpec{}
 	clspec := logging.CollectionSpec{
 		Fluentd: tunings,
@@ -0,0 +1,63 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/utils/comparators/routes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Route reconciles a Route to the desired spec returning an error
+// if there is an issue creating or updating to the desired state
+func Route(er record.EventRecorder, k8Client client.Client, desired *routev1.Route) error {
+	reason := constants.EventReasonGetObject
+	updateReason := ""
+	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current := &routev1.Route{}
+		key := client.ObjectKeyFromObject(desired)
+		if err := k8Client.Get(context.TODO(), key, current); err != nil {
+			if errors.IsNotFound(err) {
+				reason = constants.EventReasonCreateObject
+				return k8Client.Create(context.TODO(), desired)
+			}
+			return fmt.Errorf("failed to get %v Route: %w", key, err)
+		}
+		same := false
+
+		if same, updateReason = routes.AreSame(current, desired); same {
+			log.V(3).Info("Route is the same skipping update")
+			return nil
+		}
+
+		reason = constants.EventReasonUpdateObject
+		current.Labels = desired.Labels
+		if desired.Spec.Host != "" {
+			current.Spec.Host = desired.Spec.Host
+		}
+		current.Spec.To = desired.Spec.To
+		current.Spec.Port = desired.Spec.Port
+		current.Spec.TLS = desired.Spec.TLS
+		current.OwnerReferences = desired.OwnerReferences
+		return k8Client.Update(context.TODO(), current)
+	})
+
+	eventType := corev1.EventTypeNormal
+	msg := fmt.Sprintf("%s Route %s/%s", reason, desired.Namespace, desired.Name)
+	if updateReason != "" {
+		msg = fmt.Sprintf("%s because of change in %s.", msg, updateReason)
+	}
+	if retryErr != nil {
+		eventType = corev1.EventTypeWarning
+		msg = fmt.Sprintf("Unable to %s: %v", msg, retryErr)
+	}
+	er.Event(desired, eventType, reason, msg)
+	return retryErr
+}
@@ -40,6 +40,7 @@ func Service(er record.EventRecorder, k8Client client.Client, desired *corev1.Se
 		//Explicitly copying because services are immutable
 		current.Labels = desired.Labels
 		current.Spec.Selector = desired.Spec.Selector
+		current.Spec.Type = desired.Spec.Type
 		current.Spec.Ports = desired.Spec.Ports
 		current.OwnerReferences = desired.OwnerReferences
 		return k8Client.Update(context.TODO(), current)
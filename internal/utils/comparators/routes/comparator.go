@@ -0,0 +1,37 @@
+package routes
+
+import (
+	"reflect"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+// AreSame compares for equality and return true equal otherwise false
+func AreSame(current *routev1.Route, desired *routev1.Route) (bool, string) {
+	log.V(3).Info("Comparing Routes current to desired", "current", current, "desired", desired)
+
+	if !utils.AreMapsSame(current.ObjectMeta.Labels, desired.ObjectMeta.Labels) {
+		log.V(3).Info("Route label change", "current name", current.Name)
+		return false, "meta.labels"
+	}
+	// the host is generated by the router unless set
+	if desired.Spec.Host != "" && current.Spec.Host != desired.Spec.Host {
+		return false, "spec.host"
+	}
+	if current.Spec.To.Kind != desired.Spec.To.Kind || current.Spec.To.Name != desired.Spec.To.Name {
+		return false, "spec.to"
+	}
+	if !reflect.DeepEqual(current.Spec.Port, desired.Spec.Port) {
+		return false, "spec.port"
+	}
+	if !reflect.DeepEqual(current.Spec.TLS, desired.Spec.TLS) {
+		return false, "spec.tls"
+	}
+	if !reflect.DeepEqual(current.GetOwnerReferences(), desired.GetOwnerReferences()) {
+		log.V(3).Info("Route Ownership change", "current name", current.Name)
+		return false, "spec.ownerReference"
+	}
+	return true, ""
+}
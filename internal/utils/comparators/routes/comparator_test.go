@@ -0,0 +1,52 @@
+package routes_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils/comparators/routes"
+)
+
+var _ = Describe("routes#AreSame", func() {
+
+	var (
+		current, desired *routev1.Route
+	)
+
+	BeforeEach(func() {
+		desired = runtime.NewRoute("aNamespace", "aRoute", "aService", "8443")
+		desired.Labels = map[string]string{}
+		desired.Spec.TLS = &routev1.TLSConfig{Termination: routev1.TLSTerminationPassthrough}
+		current = desired.DeepCopy()
+	})
+
+	It("should recognize they are the same", func() {
+		ok, _ := routes.AreSame(current, desired)
+		Expect(ok).To(BeTrue())
+	})
+	It("should ignore the host generated by the router", func() {
+		current.Spec.Host = "aRoute-aNamespace.apps.example.com"
+		ok, _ := routes.AreSame(current, desired)
+		Expect(ok).To(BeTrue())
+	})
+	It("should recognize a different host", func() {
+		current.Spec.Host = "aRoute-aNamespace.apps.example.com"
+		desired.Spec.Host = "logs.example.com"
+		ok, reason := routes.AreSame(current, desired)
+		Expect(ok).To(BeFalse())
+		Expect(reason).To(Equal("spec.host"))
+	})
+	It("should recognize a different TLS termination", func() {
+		desired.Spec.TLS.Termination = routev1.TLSTerminationReencrypt
+		ok, reason := routes.AreSame(current, desired)
+		Expect(ok).To(BeFalse())
+		Expect(reason).To(Equal("spec.tls"))
+	})
+	It("should recognize different labels", func() {
+		desired.Labels["foo"] = "bar"
+		ok, reason := routes.AreSame(current, desired)
+		Expect(ok).To(BeFalse())
+		Expect(reason).To(Equal("meta.labels"))
+	})
+})
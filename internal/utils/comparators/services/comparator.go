@@ -21,11 +21,20 @@ func AreSame(current *v1.Service, desired *v1.Service) (bool, string) {
 		log.V(3).Info("Service Selector change", "current name", current.Name)
 		return false, "spec.selector"
 	}
+	if serviceType(current) != serviceType(desired) {
+		log.V(3).Info("Service type change", "current name", current.Name)
+		return false, "spec.type"
+	}
+
 	if len(current.Spec.Ports) != len(desired.Spec.Ports) {
 		return false, "spec.ports"
 	}
 	for i, port := range current.Spec.Ports {
 		dPort := desired.Spec.Ports[i]
+		// node ports are allocated by the server unless set
+		if dPort.NodePort == 0 {
+			dPort.NodePort = port.NodePort
+		}
 		if !reflect.DeepEqual(port, dPort) {
 			return false, fmt.Sprintf("spec.ports[%d]", i)
 		}
@@ -39,3 +48,11 @@ func AreSame(current *v1.Service, desired *v1.Service) (bool, string) {
 
 	return true, ""
 }
+
+// serviceType returns the type of the service, defaults to ClusterIP
+func serviceType(service *v1.Service) v1.ServiceType {
+	if service.Spec.Type == "" {
+		return v1.ServiceTypeClusterIP
+	}
+	return service.Spec.Type
+}
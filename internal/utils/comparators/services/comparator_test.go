@@ -71,5 +71,24 @@ var _ = Describe("services#AreSame", func() {
 			ok, _ := services.AreSame(current, desired)
 			Expect(ok).To(BeFalse())
 		})
+		It("should ignore node ports allocated by the server", func() {
+			current.Spec.Ports = append(current.Spec.Ports, v1.ServicePort{Name: "bar", Port: 1050, NodePort: 30050})
+			desired.Spec.Ports = append(desired.Spec.Ports, v1.ServicePort{Name: "bar", Port: 1050})
+			ok, _ := services.AreSame(current, desired)
+			Expect(ok).To(BeTrue())
+		})
+	})
+	Context("when evaluating the type", func() {
+		It("should recognize the default type is ClusterIP", func() {
+			desired.Spec.Type = v1.ServiceTypeClusterIP
+			ok, _ := services.AreSame(current, desired)
+			Expect(ok).To(BeTrue())
+		})
+		It("should recognize they are different", func() {
+			desired.Spec.Type = v1.ServiceTypeLoadBalancer
+			ok, reason := services.AreSame(current, desired)
+			Expect(ok).To(BeFalse())
+			Expect(reason).To(Equal("spec.type"))
+		})
 	})
 })
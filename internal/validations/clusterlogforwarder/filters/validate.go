@@ -0,0 +1,39 @@
+package filters
+
+import (
+	"fmt"
+
+	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter"
+	"github.com/openshift/cluster-logging-operator/internal/validations/clusterlogforwarder/conditions"
+)
+
+// Verify and set status.Filters conditions
+func Verify(filters []loggingv1.FilterSpec, status *loggingv1.ClusterLogForwarderStatus) {
+	status.Filters = loggingv1.NamedConditions{}
+
+	for i, f := range filters {
+		i, f := i, f // Don't bind range variables.
+		badFilter := func(format string, args ...interface{}) {
+			if f.Name == "" {
+				f.Name = fmt.Sprintf("filter_%v_", i)
+			}
+			status.Filters.Set(f.Name, conditions.CondInvalid(format, args...))
+		}
+
+		switch {
+		case f.Name == "":
+			badFilter("filter must have a name")
+		case len(status.Filters[f.Name]) > 0:
+			badFilter("duplicate name: %q", f.Name)
+		case !loggingv1.IsFilterTypeName(f.Type):
+			badFilter("unknown filter type %q", f.Type)
+		default:
+			if _, err := filter.RemapVRL(&f); err != nil {
+				badFilter("%v", err)
+			} else {
+				status.Filters.Set(f.Name, conditions.CondReady)
+			}
+		}
+	}
+}
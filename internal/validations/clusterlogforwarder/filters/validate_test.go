@@ -0,0 +1,143 @@
+package filters
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("#Verify", func() {
+	var (
+		clfStatus *loggingv1.ClusterLogForwarderStatus
+	)
+
+	BeforeEach(func() {
+		clfStatus = &loggingv1.ClusterLogForwarderStatus{}
+	})
+
+	Context("when validating the name and type", func() {
+		It("should fail if filter does not have a name", func() {
+			Verify([]loggingv1.FilterSpec{{Type: loggingv1.FilterKubeAPIAudit}}, clfStatus)
+			Expect(clfStatus.Filters["filter_0_"]).To(HaveCondition("Ready", false, loggingv1.ReasonInvalid, "filter must have a name"))
+		})
+		It("should fail if filter names are not unique", func() {
+			Verify([]loggingv1.FilterSpec{
+				{Name: "my-filter", Type: loggingv1.FilterKubeAPIAudit},
+				{Name: "my-filter", Type: loggingv1.FilterKubeAPIAudit},
+			}, clfStatus)
+			Expect(clfStatus.Filters["my-filter"]).To(HaveCondition("Ready", false, loggingv1.ReasonInvalid, `duplicate name: "my-filter"`))
+		})
+		It("should fail if filter type is unknown", func() {
+			Verify([]loggingv1.FilterSpec{{Name: "my-filter", Type: "notatype"}}, clfStatus)
+			Expect(clfStatus.Filters["my-filter"]).To(HaveCondition("Ready", false, loggingv1.ReasonInvalid, `unknown filter type "notatype"`))
+		})
+		It("should pass a kubeAPIAudit filter", func() {
+			Verify([]loggingv1.FilterSpec{{Name: "my-filter", Type: loggingv1.FilterKubeAPIAudit}}, clfStatus)
+			Expect(clfStatus.Filters["my-filter"]).To(HaveCondition("Ready", true, "", ""))
+		})
+	})
+
+	DescribeTable("when validating a drop filter",
+		func(tests []loggingv1.DropTest, message string) {
+			Verify([]loggingv1.FilterSpec{{
+				Name:           "my-drop",
+				Type:           loggingv1.FilterDrop,
+				FilterTypeSpec: loggingv1.FilterTypeSpec{Drop: tests},
+			}}, clfStatus)
+			if message == "" {
+				Expect(clfStatus.Filters["my-drop"]).To(HaveCondition("Ready", true, "", ""))
+			} else {
+				Expect(clfStatus.Filters["my-drop"]).To(HaveCondition("Ready", false, loggingv1.ReasonInvalid, message))
+			}
+		},
+		Entry("should pass with valid tests", []loggingv1.DropTest{
+			{DropConditions: []loggingv1.DropCondition{
+				{Field: ".kubernetes.namespace_name", Matches: "^openshift-"},
+				{Field: `.kubernetes.labels."app.kubernetes.io/name"`, NotMatches: "important"},
+			}},
+			{DropConditions: []loggingv1.DropCondition{{Field: ".level", Matches: "debug"}}},
+		}, ""),
+		Entry("should fail without tests", nil, "drop filter must define at least one test"),
+		Entry("should fail with a test without conditions", []loggingv1.DropTest{{}}, "drop test 0 must define at least one condition"),
+		Entry("should fail with a field not starting with '.'", []loggingv1.DropTest{
+			{DropConditions: []loggingv1.DropCondition{{Field: "level", Matches: "debug"}}},
+		}, `drop test 0: invalid field path "level"`),
+		Entry("should fail with an unquoted field segment containing illegal characters", []loggingv1.DropTest{
+			{DropConditions: []loggingv1.DropCondition{{Field: ".kubernetes.labels.app/name", Matches: "debug"}}},
+		}, `drop test 0: invalid field path ".kubernetes.labels.app/name"`),
+		Entry("should fail with a quoted field segment ending the VRL program", []loggingv1.DropTest{
+			{DropConditions: []loggingv1.DropCondition{{Field: `.kubernetes.labels."a'''b"`, Matches: "debug"}}},
+		}, `drop test 0: invalid field path ".kubernetes.labels.\\"a'''b\\""`),
+		Entry("should fail with both matches and notMatches", []loggingv1.DropTest{
+			{DropConditions: []loggingv1.DropCondition{{Field: ".level", Matches: "debug", NotMatches: "error"}}},
+		}, `drop test 0: field ".level" must define exactly one of matches or notMatches`),
+		Entry("should fail with neither matches nor notMatches", []loggingv1.DropTest{
+			{DropConditions: []loggingv1.DropCondition{{Field: ".level"}}},
+		}, `drop test 0: field ".level" must define exactly one of matches or notMatches`),
+		Entry("should fail with an invalid regular expression", []loggingv1.DropTest{
+			{DropConditions: []loggingv1.DropCondition{{Field: ".level", Matches: "debug("}}},
+		}, `drop test 0: field ".level" has an invalid regular expression "debug\("`),
+	)
+
+	DescribeTable("when validating a prune filter",
+		func(spec *loggingv1.PruneFilterSpec, message string) {
+			Verify([]loggingv1.FilterSpec{{
+				Name:           "my-prune",
+				Type:           loggingv1.FilterPrune,
+				FilterTypeSpec: loggingv1.FilterTypeSpec{Prune: spec},
+			}}, clfStatus)
+			if message == "" {
+				Expect(clfStatus.Filters["my-prune"]).To(HaveCondition("Ready", true, "", ""))
+			} else {
+				Expect(clfStatus.Filters["my-prune"]).To(HaveCondition("Ready", false, loggingv1.ReasonInvalid, message))
+			}
+		},
+		Entry("should pass with in", &loggingv1.PruneFilterSpec{
+			In: []string{".kubernetes.annotations", ".kubernetes.labels", ".kubernetes.namespace_labels"},
+		}, ""),
+		Entry("should pass with notIn including required fields", &loggingv1.PruneFilterSpec{
+			NotIn: []string{".log_type", ".message", ".kubernetes.namespace_name"},
+		}, ""),
+		Entry("should fail without fields", &loggingv1.PruneFilterSpec{}, "prune filter must define at least one of in or notIn"),
+		Entry("should fail without spec", nil, "prune filter must define at least one of in or notIn"),
+		Entry("should fail with an invalid field path", &loggingv1.PruneFilterSpec{
+			In: []string{"kubernetes.labels"},
+		}, `prune filter: invalid field path "kubernetes.labels"`),
+		Entry("should fail with a backslash in a quoted field segment", &loggingv1.PruneFilterSpec{
+			In: []string{`.kubernetes.labels."a\b"`},
+		}, `prune filter: invalid field path ".kubernetes.labels.\\"a\\\\b\\""`),
+		Entry("should fail if in removes .log_type", &loggingv1.PruneFilterSpec{
+			In: []string{".log_type"},
+		}, `prune filter: in can not include required field ".log_type"`),
+		Entry("should fail if in removes .message", &loggingv1.PruneFilterSpec{
+			In: []string{".message"},
+		}, `prune filter: in can not include required field ".message"`),
+		Entry("should fail if notIn does not keep .message", &loggingv1.PruneFilterSpec{
+			NotIn: []string{".log_type", ".kubernetes"},
+		}, `prune filter: notIn must include required field ".message"`),
+		Entry("should fail if in removes the quoted form of .message", &loggingv1.PruneFilterSpec{
+			In: []string{`."message"`},
+		}, `prune filter: in can not include required field ".message"`),
+	)
+
+	DescribeTable("when validating a remap filter",
+		func(spec *loggingv1.RemapFilterSpec, message string) {
+			Verify([]loggingv1.FilterSpec{{
+				Name:           "my-remap",
+				Type:           loggingv1.FilterRemap,
+				FilterTypeSpec: loggingv1.FilterTypeSpec{Remap: spec},
+			}}, clfStatus)
+			if message == "" {
+				Expect(clfStatus.Filters["my-remap"]).To(HaveCondition("Ready", true, "", ""))
+			} else {
+				Expect(clfStatus.Filters["my-remap"]).To(HaveCondition("Ready", false, loggingv1.ReasonInvalid, message))
+			}
+		},
+		Entry("should pass a valid program", &loggingv1.RemapFilterSpec{Source: `.level = "info"`}, ""),
+		Entry("should fail without spec", nil, "remap filter requires a source program"),
+		Entry("should fail with unbalanced brackets", &loggingv1.RemapFilterSpec{Source: `.a = [1`}, "remap source: unclosed '\\['"),
+		Entry("should fail with a forbidden function", &loggingv1.RemapFilterSpec{Source: `.a = get_env_var!("HOME")`}, "function get_env_var is not allowed"),
+	)
+})
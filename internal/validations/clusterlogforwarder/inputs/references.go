@@ -0,0 +1,108 @@
+package inputs
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/status"
+	"github.com/openshift/cluster-logging-operator/internal/validations/clusterlogforwarder/conditions"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// VerifyReferences verifies the client CAs, authentication and serving certificate secrets referenced by valid
+// receiver inputs exist and set status.Inputs conditions of inputs with missing references
+func VerifyReferences(namespace string, k8sClient client.Client, inputs []loggingv1.InputSpec, status *loggingv1.ClusterLogForwarderStatus) {
+	for _, input := range inputs {
+		input := input // Don't bind range variables.
+		if input.Receiver == nil || !status.Inputs[input.Name].IsTrueFor(loggingv1.ConditionReady) {
+			continue
+		}
+		var clientCA *loggingv1.ValueReference
+		var auth *loggingv1.HTTPReceiverAuthentication
+		switch {
+		case loggingv1.IsHttpReceiver(&input):
+			clientCA = input.Receiver.HTTP.ClientCA
+			auth = input.Receiver.HTTP.Authentication
+		case loggingv1.IsSyslogReceiver(&input):
+			clientCA = input.Receiver.Syslog.ClientCA
+		}
+		if clientCA != nil {
+			if cond, ok := verifyReference(namespace, k8sClient, "clientCA", *clientCA); !ok {
+				status.Inputs.Set(input.Name, cond)
+				continue
+			}
+		}
+		if auth != nil {
+			if cond, ok := verifyAuthenticationSecret(namespace, k8sClient, *auth); !ok {
+				status.Inputs.Set(input.Name, cond)
+				continue
+			}
+		}
+		if secretName := input.Receiver.GetTLSSecretName(); secretName != "" {
+			for _, key := range []string{constants.ClientCertKey, constants.ClientPrivateKey} {
+				if cond, ok := verifyReference(namespace, k8sClient, "exposure.tlsSecretName", loggingv1.ValueReference{Key: key, SecretName: secretName}); !ok {
+					status.Inputs.Set(input.Name, cond)
+					break
+				}
+			}
+		}
+	}
+}
+
+// verifyReference verifies a referenced configmap or secret exists and holds the key
+func verifyReference(namespace string, k8sClient client.Client, field string, ref loggingv1.ValueReference) (status.Condition, bool) {
+	if ref.ConfigMapName != "" {
+		configMap := &corev1.ConfigMap{}
+		if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: ref.ConfigMapName, Namespace: namespace}, configMap); err != nil {
+			return conditions.CondMissing("%s: configmap %q not found", field, ref.ConfigMapName), false
+		}
+		_, hasData := configMap.Data[ref.Key]
+		_, hasBinaryData := configMap.BinaryData[ref.Key]
+		if !hasData && !hasBinaryData {
+			return conditions.CondMissing("%s: key %q not found in configmap %q", field, ref.Key, ref.ConfigMapName), false
+		}
+		return status.Condition{}, true
+	}
+	secret := &corev1.Secret{}
+	if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: ref.SecretName, Namespace: namespace}, secret); err != nil {
+		return conditions.CondMissing("%s: secret %q not found", field, ref.SecretName), false
+	}
+	if len(secret.Data[ref.Key]) == 0 {
+		return conditions.CondMissing("%s: key %q not found in secret %q", field, ref.Key, ref.SecretName), false
+	}
+	return status.Condition{}, true
+}
+
+// verifyAuthenticationSecret verifies the secret of a receiver holds the credentials of the authentication type
+// in a form the collector can read
+func verifyAuthenticationSecret(namespace string, k8sClient client.Client, auth loggingv1.HTTPReceiverAuthentication) (status.Condition, bool) {
+	secret := &corev1.Secret{}
+	if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: auth.SecretName, Namespace: namespace}, secret); err != nil {
+		return conditions.CondMissing("authentication: secret %q not found", auth.SecretName), false
+	}
+	keys := []string{constants.BearerTokenFileKey}
+	if auth.Type == loggingv1.HTTPReceiverAuthBasic {
+		keys = []string{constants.ClientUsername, constants.ClientPassword}
+	}
+	for _, key := range keys {
+		if len(secret.Data[key]) == 0 {
+			return conditions.CondMissing("authentication: key %q not found in secret %q", key, auth.SecretName), false
+		}
+		// The credentials are substituted into quoted strings of the collector configuration, the trailing
+		// whitespace is removed when they are read
+		if strings.IndexFunc(strings.TrimRightFunc(string(secret.Data[key]), unicode.IsSpace), invalidCredentialRune) >= 0 {
+			return conditions.CondInvalid("authentication: key %q of secret %q must not contain quotes, backslashes or control characters", key, auth.SecretName), false
+		}
+	}
+	return status.Condition{}, true
+}
+
+// invalidCredentialRune matches the characters which break a quoted string of the collector configuration
+func invalidCredentialRune(r rune) bool {
+	return r == '"' || r == '\\' || unicode.IsControl(r)
+}
@@ -0,0 +1,128 @@
+package inputs
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/status"
+	"github.com/openshift/cluster-logging-operator/internal/validations/clusterlogforwarder/conditions"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("#VerifyReferences", func() {
+	const inputName = "receiver"
+
+	var (
+		k8sClient = fake.NewClientBuilder().WithRuntimeObjects(
+			runtime.NewConfigMap(constants.OpenshiftNS, "receiver-ca", map[string]string{"ca-bundle.crt": "cert"}),
+			runtime.NewSecret(constants.OpenshiftNS, "receiver-basic", map[string][]byte{
+				constants.ClientUsername: []byte("user"),
+				constants.ClientPassword: []byte("pass"),
+			}),
+			runtime.NewSecret(constants.OpenshiftNS, "receiver-token", map[string][]byte{
+				constants.BearerTokenFileKey: []byte("token"),
+			}),
+			runtime.NewSecret(constants.OpenshiftNS, "receiver-serving-cert", map[string][]byte{
+				constants.ClientCertKey:    []byte("cert"),
+				constants.ClientPrivateKey: []byte("key"),
+			}),
+			runtime.NewSecret(constants.OpenshiftNS, "receiver-quoted", map[string][]byte{
+				constants.ClientUsername: []byte("user\n"),
+				constants.ClientPassword: []byte(`pa"ss`),
+			}),
+			runtime.NewSecret(constants.OpenshiftNS, "receiver-token-backslash", map[string][]byte{
+				constants.BearerTokenFileKey: []byte(`to\ken`),
+			}),
+		).Build()
+	)
+
+	DescribeTable("receiver client CAs and authentication secrets", func(receiver *loggingv1.ReceiverSpec, expReason status.ConditionReason, expMsg string) {
+		inputs := []loggingv1.InputSpec{{Name: inputName, Receiver: receiver}}
+		clfStatus := &loggingv1.ClusterLogForwarderStatus{Inputs: loggingv1.NamedConditions{}}
+		clfStatus.Inputs.Set(inputName, conditions.CondReady)
+		VerifyReferences(constants.OpenshiftNS, k8sClient, inputs, clfStatus)
+		if expMsg == "" {
+			Expect(clfStatus.Inputs[inputName]).To(HaveCondition("Ready", true, "", ""))
+		} else {
+			Expect(clfStatus.Inputs[inputName]).To(HaveCondition("Ready", false, expReason, expMsg))
+		}
+	},
+		Entry("should pass for an existing client CA and basic auth secret", &loggingv1.ReceiverSpec{
+			Type: loggingv1.ReceiverTypeHttp,
+			ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{HTTP: &loggingv1.HTTPReceiver{
+				Format:         loggingv1.FormatKubeAPIAudit,
+				ClientCA:       &loggingv1.ValueReference{Key: "ca-bundle.crt", ConfigMapName: "receiver-ca"},
+				Authentication: &loggingv1.HTTPReceiverAuthentication{Type: loggingv1.HTTPReceiverAuthBasic, SecretName: "receiver-basic"},
+			}},
+		}, status.ConditionReason(""), ""),
+		Entry("should fail for a missing client CA configmap", &loggingv1.ReceiverSpec{
+			Type: loggingv1.ReceiverTypeSyslog,
+			ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{Syslog: &loggingv1.SyslogReceiver{
+				ClientCA: &loggingv1.ValueReference{Key: "ca-bundle.crt", ConfigMapName: "other-ca"},
+			}},
+		}, loggingv1.ReasonMissingResource, `clientCA: configmap "other-ca" not found`),
+		Entry("should fail for a client CA key missing in the configmap", &loggingv1.ReceiverSpec{
+			Type: loggingv1.ReceiverTypeHttp,
+			ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{HTTP: &loggingv1.HTTPReceiver{
+				Format:   loggingv1.FormatKubeAPIAudit,
+				ClientCA: &loggingv1.ValueReference{Key: "ca.crt", ConfigMapName: "receiver-ca"},
+			}},
+		}, loggingv1.ReasonMissingResource, `clientCA: key "ca.crt" not found in configmap "receiver-ca"`),
+		Entry("should fail for a missing authentication secret", &loggingv1.ReceiverSpec{
+			Type: loggingv1.ReceiverTypeHttp,
+			ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{HTTP: &loggingv1.HTTPReceiver{
+				Format:         loggingv1.FormatJSON,
+				Authentication: &loggingv1.HTTPReceiverAuthentication{Type: loggingv1.HTTPReceiverAuthBasic, SecretName: "other-auth"},
+			}},
+		}, loggingv1.ReasonMissingResource, `authentication: secret "other-auth" not found`),
+		Entry("should fail for a basic auth secret without a username and password", &loggingv1.ReceiverSpec{
+			Type: loggingv1.ReceiverTypeHttp,
+			ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{HTTP: &loggingv1.HTTPReceiver{
+				Format:         loggingv1.FormatJSON,
+				Authentication: &loggingv1.HTTPReceiverAuthentication{Type: loggingv1.HTTPReceiverAuthBasic, SecretName: "receiver-token"},
+			}},
+		}, loggingv1.ReasonMissingResource, `authentication: key "username" not found in secret "receiver-token"`),
+		Entry("should pass for an existing bearer token secret", &loggingv1.ReceiverSpec{
+			Type: loggingv1.ReceiverTypeHttp,
+			ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{HTTP: &loggingv1.HTTPReceiver{
+				Format:         loggingv1.FormatJSON,
+				Authentication: &loggingv1.HTTPReceiverAuthentication{Type: loggingv1.HTTPReceiverAuthBearerToken, SecretName: "receiver-token"},
+			}},
+		}, status.ConditionReason(""), ""),
+		Entry("should fail for a bearer token secret without a token", &loggingv1.ReceiverSpec{
+			Type: loggingv1.ReceiverTypeHttp,
+			ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{HTTP: &loggingv1.HTTPReceiver{
+				Format:         loggingv1.FormatJSON,
+				Authentication: &loggingv1.HTTPReceiverAuthentication{Type: loggingv1.HTTPReceiverAuthBearerToken, SecretName: "receiver-basic"},
+			}},
+		}, loggingv1.ReasonMissingResource, `authentication: key "token" not found in secret "receiver-basic"`),
+		Entry("should fail for a bearer token with a backslash", &loggingv1.ReceiverSpec{
+			Type: loggingv1.ReceiverTypeHttp,
+			ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{HTTP: &loggingv1.HTTPReceiver{
+				Format:         loggingv1.FormatJSON,
+				Authentication: &loggingv1.HTTPReceiverAuthentication{Type: loggingv1.HTTPReceiverAuthBearerToken, SecretName: "receiver-token-backslash"},
+			}},
+		}, loggingv1.ReasonInvalid, `authentication: key "token" of secret "receiver-token-backslash" must not contain quotes, backslashes or control characters`),
+		Entry("should fail for basic auth credentials with quotes", &loggingv1.ReceiverSpec{
+			Type: loggingv1.ReceiverTypeHttp,
+			ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{HTTP: &loggingv1.HTTPReceiver{
+				Format:         loggingv1.FormatJSON,
+				Authentication: &loggingv1.HTTPReceiverAuthentication{Type: loggingv1.HTTPReceiverAuthBasic, SecretName: "receiver-quoted"},
+			}},
+		}, loggingv1.ReasonInvalid, `authentication: key "password" of secret "receiver-quoted" must not contain quotes, backslashes or control characters`),
+		Entry("should pass for an existing serving certificate of an exposed receiver", &loggingv1.ReceiverSpec{
+			Type:             loggingv1.ReceiverTypeHttp,
+			ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{HTTP: &loggingv1.HTTPReceiver{Format: loggingv1.FormatJSON}},
+			Exposure:         &loggingv1.ReceiverExposure{Type: loggingv1.ReceiverExposureRoute, TLSSecretName: "receiver-serving-cert"},
+		}, status.ConditionReason(""), ""),
+		Entry("should fail for a serving certificate secret without a key", &loggingv1.ReceiverSpec{
+			Type:             loggingv1.ReceiverTypeSyslog,
+			ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{Syslog: &loggingv1.SyslogReceiver{}},
+			Exposure:         &loggingv1.ReceiverExposure{Type: loggingv1.ReceiverExposureLoadBalancer, TLSSecretName: "receiver-basic"},
+		}, loggingv1.ReasonMissingResource, `exposure.tlsSecretName: key "tls.crt" not found in secret "receiver-basic"`),
+	)
+})
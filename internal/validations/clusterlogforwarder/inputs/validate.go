@@ -4,9 +4,12 @@ import (
 	"fmt"
 	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/utils/sets"
 	"github.com/openshift/cluster-logging-operator/internal/validations/clusterlogforwarder/conditions"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"regexp"
 	"strings"
 )
 
@@ -63,8 +66,11 @@ func Verify(inputs []loggingv1.InputSpec, status *loggingv1.ClusterLogForwarderS
 			badInput("invalid port specified for HTTP receiver")
 		case loggingv1.IsSyslogReceiver(&input) && !validPort(input.Receiver.Syslog.Port):
 			badInput("invalid port specified for Syslog receiver")
-		case loggingv1.IsHttpReceiver(&input) && input.Receiver.HTTP.Format != loggingv1.FormatKubeAPIAudit:
+		case loggingv1.IsHttpReceiver(&input) && !httpReceiverFormats.Has(input.Receiver.HTTP.Format):
 			badInput("invalid format specified for HTTP receiver")
+		case loggingv1.IsHttpReceiver(&input) && !validHttpReceiver(input, status):
+		case loggingv1.IsSyslogReceiver(&input) && !validSyslogReceiver(input, status):
+		case isAReceiver(input) && !validExposure(input, status):
 		default:
 			status.Inputs.Set(input.Name, conditions.CondReady)
 		}
@@ -88,6 +94,104 @@ func hasOneType(spec loggingv1.InputSpec) bool {
 	return totTypes == 1
 }
 
+var (
+	// receiverLogTypeRegex matches the log types a receiver may assign
+	receiverLogTypeRegex = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,62}$`)
+
+	httpReceiverFormats   = sets.NewString(loggingv1.FormatKubeAPIAudit, loggingv1.FormatJSON, loggingv1.FormatNDJSON, loggingv1.FormatText)
+	httpReceiverAuthTypes = sets.NewString(loggingv1.HTTPReceiverAuthBasic, loggingv1.HTTPReceiverAuthBearerToken)
+
+	receiverExposureTypes = sets.NewString(loggingv1.ReceiverExposureClusterIP, loggingv1.ReceiverExposureNodePort, loggingv1.ReceiverExposureLoadBalancer, loggingv1.ReceiverExposureRoute)
+)
+
+func validHttpReceiver(spec loggingv1.InputSpec, status *loggingv1.ClusterLogForwarderStatus) bool {
+	http := spec.Receiver.HTTP
+	switch {
+	case http.LogType != "" && http.Format == loggingv1.FormatKubeAPIAudit:
+		status.Inputs.Set(spec.Name, conditions.CondInvalid("logType is not supported for HTTP receiver format %q", http.Format))
+	case !receiverLogTypeRegex.MatchString(http.GetLogType()):
+		status.Inputs.Set(spec.Name, conditions.CondInvalid("invalid logType specified for HTTP receiver: %q", http.LogType))
+	case http.FieldMapping != nil && (http.Format == loggingv1.FormatKubeAPIAudit || http.Format == loggingv1.FormatText):
+		status.Inputs.Set(spec.Name, conditions.CondInvalid("fieldMapping is not supported for HTTP receiver format %q", http.Format))
+	case http.FieldMapping != nil && invalidFieldPath(*http.FieldMapping) != "":
+		status.Inputs.Set(spec.Name, conditions.CondInvalid("fieldMapping: invalid field path %q", invalidFieldPath(*http.FieldMapping)))
+	case http.ClientCA != nil && clientCAError(*http.ClientCA) != "":
+		status.Inputs.Set(spec.Name, conditions.CondInvalid("%s", clientCAError(*http.ClientCA)))
+	case http.Authentication != nil && !httpReceiverAuthTypes.Has(http.Authentication.Type):
+		status.Inputs.Set(spec.Name, conditions.CondInvalid("invalid authentication type specified for HTTP receiver: %q", http.Authentication.Type))
+	case http.Authentication != nil && http.Authentication.SecretName == "":
+		status.Inputs.Set(spec.Name, conditions.CondInvalid("authentication: secretName is required"))
+	}
+	return len(status.Inputs[spec.Name]) == 0
+}
+
+// invalidFieldPath is the first invalid path of a field mapping, empty if all are valid
+func invalidFieldPath(mapping loggingv1.HTTPReceiverFieldMapping) string {
+	for _, path := range []string{mapping.Message, mapping.Timestamp, mapping.Level} {
+		if path != "" && !helpers.IsFieldPath(path) {
+			return path
+		}
+	}
+	return ""
+}
+
+// clientCAError is the reason a client CA reference is invalid, empty if it is valid
+func clientCAError(ref loggingv1.ValueReference) string {
+	switch {
+	case ref.Key == "":
+		return "clientCA: key is required"
+	case (ref.ConfigMapName == "") == (ref.SecretName == ""):
+		return "clientCA: exactly one of configMapName or secretName is required"
+	}
+	return ""
+}
+
+func validSyslogReceiver(spec loggingv1.InputSpec, status *loggingv1.ClusterLogForwarderStatus) bool {
+	syslog := spec.Receiver.Syslog
+	protocols := sets.NewString(loggingv1.SyslogReceiverProtocolTCP, loggingv1.SyslogReceiverProtocolUDP, loggingv1.SyslogReceiverProtocolTLS)
+	switch {
+	case !protocols.Has(syslog.GetProtocol()):
+		status.Inputs.Set(spec.Name, conditions.CondInvalid("invalid protocol specified for Syslog receiver: %q", syslog.Protocol))
+	case syslog.ClientCA != nil && syslog.GetProtocol() != loggingv1.SyslogReceiverProtocolTLS:
+		status.Inputs.Set(spec.Name, conditions.CondInvalid("clientCA requires protocol %q for Syslog receiver", loggingv1.SyslogReceiverProtocolTLS))
+	case syslog.ClientCA != nil && clientCAError(*syslog.ClientCA) != "":
+		status.Inputs.Set(spec.Name, conditions.CondInvalid("%s", clientCAError(*syslog.ClientCA)))
+	case !receiverLogTypeRegex.MatchString(syslog.GetLogType()):
+		status.Inputs.Set(spec.Name, conditions.CondInvalid("invalid logType specified for Syslog receiver: %q", syslog.LogType))
+	}
+	return len(status.Inputs[spec.Name]) == 0
+}
+
+// validExposure verifies the exposure of a receiver outside the cluster, routes require a TLS receiver and
+// TLS receivers require a certificate for the address of the exposure
+func validExposure(spec loggingv1.InputSpec, status *loggingv1.ClusterLogForwarderStatus) bool {
+	exposure := spec.Receiver.Exposure
+	if exposure == nil {
+		return true
+	}
+	exposureType := spec.Receiver.GetExposureType()
+	plainText := loggingv1.IsSyslogReceiver(&spec) && spec.Receiver.Syslog.GetProtocol() != loggingv1.SyslogReceiverProtocolTLS
+	switch {
+	case !receiverExposureTypes.Has(exposureType):
+		status.Inputs.Set(spec.Name, conditions.CondInvalid("invalid exposure type specified for receiver: %q", exposure.Type))
+	case exposure.Host != "" && exposureType != loggingv1.ReceiverExposureRoute:
+		status.Inputs.Set(spec.Name, conditions.CondInvalid("exposure: host requires type %q", loggingv1.ReceiverExposureRoute))
+	case exposure.Host != "" && len(validation.IsDNS1123Subdomain(exposure.Host)) > 0:
+		status.Inputs.Set(spec.Name, conditions.CondInvalid("exposure: invalid host %q", exposure.Host))
+	case exposureType == loggingv1.ReceiverExposureRoute && plainText:
+		status.Inputs.Set(spec.Name, conditions.CondInvalid("exposure type %q requires protocol %q for Syslog receiver", loggingv1.ReceiverExposureRoute, loggingv1.SyslogReceiverProtocolTLS))
+	case exposureType == loggingv1.ReceiverExposureClusterIP && exposure.TLSSecretName != "":
+		status.Inputs.Set(spec.Name, conditions.CondInvalid("exposure: tlsSecretName is not supported for type %q", loggingv1.ReceiverExposureClusterIP))
+	case plainText && exposure.TLSSecretName != "":
+		status.Inputs.Set(spec.Name, conditions.CondInvalid("exposure: tlsSecretName requires protocol %q for Syslog receiver", loggingv1.SyslogReceiverProtocolTLS))
+	case exposureType != loggingv1.ReceiverExposureClusterIP && !plainText && exposure.TLSSecretName == "":
+		status.Inputs.Set(spec.Name, conditions.CondInvalid("exposure: tlsSecretName is required for type %q, the certificate of the input service is not valid outside the cluster", exposureType))
+	case exposureType != loggingv1.ReceiverExposureClusterIP && plainText && loggingv1.ReservedInputNames.Has(loggingv1.ReceiverLogType(&spec)):
+		status.Inputs.Set(spec.Name, conditions.CondInvalid("exposure: plain text Syslog receiver of log type %q must not be exposed outside the cluster, use protocol %q or a custom logType", loggingv1.ReceiverLogType(&spec), loggingv1.SyslogReceiverProtocolTLS))
+	}
+	return len(status.Inputs[spec.Name]) == 0
+}
+
 var (
 	conditionInfraValidationSourcesFailure = loggingv1.NewCondition(loggingv1.ValidationCondition,
 		corev1.ConditionTrue,
@@ -2,6 +2,7 @@ package inputs
 
 import (
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
@@ -341,6 +342,192 @@ var _ = Describe("#Validate", func() {
 			checkReceiver(&loggingv1.ReceiverSpec{}, `invalid ReceiverTypeSpec specified for receiver`, map[string]bool{constants.VectorName: true})
 			checkReceiver(&loggingv1.ReceiverSpec{}, `ReceiverSpecs are only supported for the vector log collector`, map[string]bool{})
 		})
+
+		DescribeTable("syslog receiver protocol, client CA and log type", func(syslog *loggingv1.SyslogReceiver, expMsg string) {
+			syslog.Port = 10514
+			inputs = []loggingv1.InputSpec{
+				{
+					Name: "receiver",
+					Receiver: &loggingv1.ReceiverSpec{
+						Type:             loggingv1.ReceiverTypeSyslog,
+						ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{Syslog: syslog},
+					},
+				},
+			}
+			Verify(inputs, clfStatus, map[string]bool{constants.VectorName: true})
+			if expMsg == "" {
+				Expect(clfStatus.Inputs["receiver"]).To(HaveCondition("Ready", true, "", ""))
+			} else {
+				Expect(clfStatus.Inputs["receiver"]).To(HaveCondition("Ready", false, loggingv1.ReasonInvalid, expMsg))
+			}
+		},
+			Entry("should pass with the defaults", &loggingv1.SyslogReceiver{}, ""),
+			Entry("should pass for udp with an application log type", &loggingv1.SyslogReceiver{
+				Protocol: loggingv1.SyslogReceiverProtocolUDP,
+				LogType:  loggingv1.InputNameApplication,
+			}, ""),
+			Entry("should pass for tls with a client CA and a custom log type", &loggingv1.SyslogReceiver{
+				Protocol: loggingv1.SyslogReceiverProtocolTLS,
+				ClientCA: &loggingv1.ValueReference{Key: "ca-bundle.crt", ConfigMapName: "syslog-ca"},
+				LogType:  "appliances",
+			}, ""),
+			Entry("should fail for an unknown protocol", &loggingv1.SyslogReceiver{Protocol: "sctp"},
+				`invalid protocol specified for Syslog receiver: "sctp"`),
+			Entry("should fail for a client CA without tls", &loggingv1.SyslogReceiver{
+				Protocol: loggingv1.SyslogReceiverProtocolTCP,
+				ClientCA: &loggingv1.ValueReference{Key: "ca-bundle.crt", ConfigMapName: "syslog-ca"},
+			}, `clientCA requires protocol "tls" for Syslog receiver`),
+			Entry("should fail for a client CA without a key", &loggingv1.SyslogReceiver{
+				ClientCA: &loggingv1.ValueReference{ConfigMapName: "syslog-ca"},
+			}, `clientCA: key is required`),
+			Entry("should fail for a client CA referencing both a configmap and a secret", &loggingv1.SyslogReceiver{
+				ClientCA: &loggingv1.ValueReference{Key: "ca-bundle.crt", ConfigMapName: "syslog-ca", SecretName: "syslog-ca"},
+			}, `clientCA: exactly one of configMapName or secretName is required`),
+			Entry("should fail for an invalid log type", &loggingv1.SyslogReceiver{LogType: "Network Appliances"},
+				`invalid logType specified for Syslog receiver: "Network Appliances"`),
+		)
+
+		DescribeTable("receiver exposure", func(receiver *loggingv1.ReceiverSpec, expMsg string) {
+			inputs = []loggingv1.InputSpec{
+				{
+					Name:     "receiver",
+					Receiver: receiver,
+				},
+			}
+			Verify(inputs, clfStatus, map[string]bool{constants.VectorName: true})
+			if expMsg == "" {
+				Expect(clfStatus.Inputs["receiver"]).To(HaveCondition("Ready", true, "", ""))
+			} else {
+				Expect(clfStatus.Inputs["receiver"]).To(HaveCondition("Ready", false, loggingv1.ReasonInvalid, expMsg))
+			}
+		},
+			Entry("should pass for a http receiver exposed by a route with a host", &loggingv1.ReceiverSpec{
+				Type:             loggingv1.ReceiverTypeHttp,
+				ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{HTTP: &loggingv1.HTTPReceiver{Port: 8443, Format: loggingv1.FormatKubeAPIAudit}},
+				Exposure:         &loggingv1.ReceiverExposure{Type: loggingv1.ReceiverExposureRoute, Host: "audit.apps.example.com", TLSSecretName: "audit-serving-cert"},
+			}, ""),
+			Entry("should pass for a udp syslog receiver of a custom log type exposed by a load balancer", &loggingv1.ReceiverSpec{
+				Type:             loggingv1.ReceiverTypeSyslog,
+				ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{Syslog: &loggingv1.SyslogReceiver{Port: 10514, Protocol: loggingv1.SyslogReceiverProtocolUDP, LogType: "appliances"}},
+				Exposure:         &loggingv1.ReceiverExposure{Type: loggingv1.ReceiverExposureLoadBalancer},
+			}, ""),
+			Entry("should pass for a tls syslog receiver of the audit log type exposed by a node port", &loggingv1.ReceiverSpec{
+				Type:             loggingv1.ReceiverTypeSyslog,
+				ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{Syslog: &loggingv1.SyslogReceiver{Port: 10514, Protocol: loggingv1.SyslogReceiverProtocolTLS, LogType: loggingv1.InputNameAudit}},
+				Exposure:         &loggingv1.ReceiverExposure{Type: loggingv1.ReceiverExposureNodePort, TLSSecretName: "syslog-serving-cert"},
+			}, ""),
+			Entry("should fail for a http receiver exposed by a load balancer without a serving certificate", &loggingv1.ReceiverSpec{
+				Type:             loggingv1.ReceiverTypeHttp,
+				ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{HTTP: &loggingv1.HTTPReceiver{Port: 8443, Format: loggingv1.FormatKubeAPIAudit}},
+				Exposure:         &loggingv1.ReceiverExposure{Type: loggingv1.ReceiverExposureLoadBalancer},
+			}, `exposure: tlsSecretName is required for type "LoadBalancer"`),
+			Entry("should fail for a serving certificate of a receiver reachable inside the cluster only", &loggingv1.ReceiverSpec{
+				Type:             loggingv1.ReceiverTypeHttp,
+				ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{HTTP: &loggingv1.HTTPReceiver{Port: 8443, Format: loggingv1.FormatKubeAPIAudit}},
+				Exposure:         &loggingv1.ReceiverExposure{TLSSecretName: "audit-serving-cert"},
+			}, `exposure: tlsSecretName is not supported for type "ClusterIP"`),
+			Entry("should fail for a serving certificate of a plain text syslog receiver", &loggingv1.ReceiverSpec{
+				Type:             loggingv1.ReceiverTypeSyslog,
+				ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{Syslog: &loggingv1.SyslogReceiver{Port: 10514, Protocol: loggingv1.SyslogReceiverProtocolTCP, LogType: "appliances"}},
+				Exposure:         &loggingv1.ReceiverExposure{Type: loggingv1.ReceiverExposureNodePort, TLSSecretName: "syslog-serving-cert"},
+			}, `exposure: tlsSecretName requires protocol "tls" for Syslog receiver`),
+			Entry("should fail for a plain text syslog receiver of the default log type exposed by a load balancer", &loggingv1.ReceiverSpec{
+				Type:             loggingv1.ReceiverTypeSyslog,
+				ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{Syslog: &loggingv1.SyslogReceiver{Port: 10514, Protocol: loggingv1.SyslogReceiverProtocolUDP}},
+				Exposure:         &loggingv1.ReceiverExposure{Type: loggingv1.ReceiverExposureLoadBalancer},
+			}, `exposure: plain text Syslog receiver of log type "infrastructure" must not be exposed outside the cluster`),
+			Entry("should fail for a plain text syslog receiver of the audit log type exposed by a node port", &loggingv1.ReceiverSpec{
+				Type:             loggingv1.ReceiverTypeSyslog,
+				ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{Syslog: &loggingv1.SyslogReceiver{Port: 10514, Protocol: loggingv1.SyslogReceiverProtocolTCP, LogType: loggingv1.InputNameAudit}},
+				Exposure:         &loggingv1.ReceiverExposure{Type: loggingv1.ReceiverExposureNodePort},
+			}, `exposure: plain text Syslog receiver of log type "audit" must not be exposed outside the cluster`),
+			Entry("should fail for an unknown exposure type", &loggingv1.ReceiverSpec{
+				Type:             loggingv1.ReceiverTypeSyslog,
+				ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{Syslog: &loggingv1.SyslogReceiver{Port: 10514}},
+				Exposure:         &loggingv1.ReceiverExposure{Type: "Ingress"},
+			}, `invalid exposure type specified for receiver: "Ingress"`),
+			Entry("should fail for a host without a route", &loggingv1.ReceiverSpec{
+				Type:             loggingv1.ReceiverTypeSyslog,
+				ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{Syslog: &loggingv1.SyslogReceiver{Port: 10514}},
+				Exposure:         &loggingv1.ReceiverExposure{Type: loggingv1.ReceiverExposureNodePort, Host: "syslog.example.com"},
+			}, `exposure: host requires type "Route"`),
+			Entry("should fail for an invalid route host", &loggingv1.ReceiverSpec{
+				Type:             loggingv1.ReceiverTypeHttp,
+				ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{HTTP: &loggingv1.HTTPReceiver{Port: 8443, Format: loggingv1.FormatKubeAPIAudit}},
+				Exposure:         &loggingv1.ReceiverExposure{Type: loggingv1.ReceiverExposureRoute, Host: "https://audit.example.com"},
+			}, `exposure: invalid host "https://audit.example.com"`),
+			Entry("should fail for a plain text syslog receiver exposed by a route", &loggingv1.ReceiverSpec{
+				Type:             loggingv1.ReceiverTypeSyslog,
+				ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{Syslog: &loggingv1.SyslogReceiver{Port: 10514, Protocol: loggingv1.SyslogReceiverProtocolTCP}},
+				Exposure:         &loggingv1.ReceiverExposure{Type: loggingv1.ReceiverExposureRoute},
+			}, `exposure type "Route" requires protocol "tls" for Syslog receiver`),
+		)
+
+		DescribeTable("http receiver format, log type and field mapping", func(http *loggingv1.HTTPReceiver, expMsg string) {
+			http.Port = 8443
+			inputs = []loggingv1.InputSpec{
+				{
+					Name: "receiver",
+					Receiver: &loggingv1.ReceiverSpec{
+						Type:             loggingv1.ReceiverTypeHttp,
+						ReceiverTypeSpec: &loggingv1.ReceiverTypeSpec{HTTP: http},
+					},
+				},
+			}
+			Verify(inputs, clfStatus, map[string]bool{constants.VectorName: true})
+			if expMsg == "" {
+				Expect(clfStatus.Inputs["receiver"]).To(HaveCondition("Ready", true, "", ""))
+			} else {
+				Expect(clfStatus.Inputs["receiver"]).To(HaveCondition("Ready", false, loggingv1.ReasonInvalid, expMsg))
+			}
+		},
+			Entry("should pass for kubeAPIAudit", &loggingv1.HTTPReceiver{Format: loggingv1.FormatKubeAPIAudit}, ""),
+			Entry("should pass for text", &loggingv1.HTTPReceiver{Format: loggingv1.FormatText}, ""),
+			Entry("should pass for json with a custom log type and field mapping", &loggingv1.HTTPReceiver{
+				Format:  loggingv1.FormatJSON,
+				LogType: "serverless",
+				FieldMapping: &loggingv1.HTTPReceiverFieldMapping{
+					Message:   ".msg",
+					Timestamp: `."@ts"`,
+					Level:     ".log.severity",
+				},
+			}, ""),
+			Entry("should fail for a log type of kubeAPIAudit", &loggingv1.HTTPReceiver{
+				Format:  loggingv1.FormatKubeAPIAudit,
+				LogType: loggingv1.InputNameApplication,
+			}, `logType is not supported for HTTP receiver format "kubeAPIAudit"`),
+			Entry("should fail for an invalid log type", &loggingv1.HTTPReceiver{Format: loggingv1.FormatNDJSON, LogType: "Serverless"},
+				`invalid logType specified for HTTP receiver: "Serverless"`),
+			Entry("should fail for a field mapping of text", &loggingv1.HTTPReceiver{
+				Format:       loggingv1.FormatText,
+				FieldMapping: &loggingv1.HTTPReceiverFieldMapping{Message: ".msg"},
+			}, `fieldMapping is not supported for HTTP receiver format "text"`),
+			Entry("should fail for an invalid field path", &loggingv1.HTTPReceiver{
+				Format:       loggingv1.FormatNDJSON,
+				FieldMapping: &loggingv1.HTTPReceiverFieldMapping{Level: "severity"},
+			}, `fieldMapping: invalid field path "severity"`),
+			Entry("should pass for mutual TLS and basic authentication", &loggingv1.HTTPReceiver{
+				Format:         loggingv1.FormatKubeAPIAudit,
+				ClientCA:       &loggingv1.ValueReference{Key: "ca-bundle.crt", SecretName: "audit-ca"},
+				Authentication: &loggingv1.HTTPReceiverAuthentication{Type: loggingv1.HTTPReceiverAuthBasic, SecretName: "audit-auth"},
+			}, ""),
+			Entry("should pass for bearer token authentication", &loggingv1.HTTPReceiver{
+				Format:         loggingv1.FormatNDJSON,
+				Authentication: &loggingv1.HTTPReceiverAuthentication{Type: loggingv1.HTTPReceiverAuthBearerToken, SecretName: "http-token"},
+			}, ""),
+			Entry("should fail for a client CA without a configmap or secret", &loggingv1.HTTPReceiver{
+				Format:   loggingv1.FormatKubeAPIAudit,
+				ClientCA: &loggingv1.ValueReference{Key: "ca-bundle.crt"},
+			}, `clientCA: exactly one of configMapName or secretName is required`),
+			Entry("should fail for an unknown authentication type", &loggingv1.HTTPReceiver{
+				Format:         loggingv1.FormatJSON,
+				Authentication: &loggingv1.HTTPReceiverAuthentication{Type: "digest", SecretName: "http-auth"},
+			}, `invalid authentication type specified for HTTP receiver: "digest"`),
+			Entry("should fail for an authentication without a secret", &loggingv1.HTTPReceiver{
+				Format:         loggingv1.FormatJSON,
+				Authentication: &loggingv1.HTTPReceiverAuthentication{Type: loggingv1.HTTPReceiverAuthBasic},
+			}, `authentication: secretName is required`),
+		)
 	})
 
 	Context("when validating application limits", func() {
@@ -5,10 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	urlhelper "github.com/openshift/cluster-logging-operator/internal/generator/url"
+	"regexp"
+	"sort"
 	"strings"
 
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
 	"github.com/openshift/cluster-logging-operator/internal/validations/clusterlogforwarder/conditions"
+	"github.com/openshift/cluster-logging-operator/internal/validations/clusterlogforwarder/filters"
 	"github.com/openshift/cluster-logging-operator/internal/validations/clusterlogforwarder/inputs"
 
 	log "github.com/ViaQ/logerr/v2/log/static"
@@ -16,6 +20,8 @@ import (
 	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/cloudwatch"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/loki"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/syslog"
 	"github.com/openshift/cluster-logging-operator/internal/status"
 	"github.com/openshift/cluster-logging-operator/internal/url"
 	"github.com/openshift/cluster-logging-operator/internal/utils/sets"
@@ -25,6 +31,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// kafkaSASLMechanisms are the SASL mechanisms supported by the kafka outputs
+var kafkaSASLMechanisms = sets.NewString(loggingv1.KafkaSASLMechanismPlain, loggingv1.KafkaSASLMechanismScramSHA256, loggingv1.KafkaSASLMechanismScramSHA512)
+
+// vectorOnlyOutputTypes are the output types without a fluentd implementation
+var vectorOnlyOutputTypes = sets.NewString(loggingv1.OutputTypeOTLP, loggingv1.OutputTypeS3, loggingv1.OutputTypeAzureMonitor, loggingv1.OutputTypeLokiStack)
+
 // ValidateInputsOutputsPipelines all inputs, outputs, and pipelines without mutating the spec
 func ValidateInputsOutputsPipelines(clf loggingv1.ClusterLogForwarder, k8sClient client.Client, extras map[string]bool) (error, *loggingv1.ClusterLogForwarderStatus) {
 
@@ -37,6 +49,7 @@ func ValidateInputsOutputsPipelines(clf loggingv1.ClusterLogForwarder, k8sClient
 	}
 
 	inputs.Verify(clf.Spec.Inputs, status, extras)
+	inputs.VerifyReferences(clf.Namespace, k8sClient, clf.Spec.Inputs, status)
 	if !status.Inputs.IsAllReady() {
 		log.V(3).Info("Input not Ready", "inputs", status.Inputs)
 	}
@@ -44,6 +57,10 @@ func ValidateInputsOutputsPipelines(clf loggingv1.ClusterLogForwarder, k8sClient
 	if !status.Outputs.IsAllReady() {
 		log.V(3).Info("Output not Ready", "outputs", status.Outputs)
 	}
+	filters.Verify(clf.Spec.Filters, status)
+	if !status.Filters.IsAllReady() {
+		log.V(3).Info("Filter not Ready", "filters", status.Filters)
+	}
 	verifyPipelines(clf.Name, &clf.Spec, status)
 	if !status.Pipelines.IsAllReady() {
 		log.V(3).Info("Pipeline not Ready", "pipelines", status.Pipelines)
@@ -57,7 +74,14 @@ func ValidateInputsOutputsPipelines(clf loggingv1.ClusterLogForwarder, k8sClient
 		}
 	}
 
-	// All pipelines have to be ready or invalid CLF
+	// All pipelines have to be ready or invalid CLF, unless invalid pipelines are dropped
+	if len(unready) > 0 && clf.Spec.InvalidPipelinePolicy == loggingv1.InvalidPipelinePolicyDrop && len(unready) < len(status.Pipelines) {
+		sort.Strings(unready)
+		log.V(3).Info("validate clusterlogforwarder. Dropping invalid pipelines", "pipelines", unready)
+		status.Conditions.SetCondition(loggingv1.NewCondition(loggingv1.ConditionDegraded, corev1.ConditionTrue, loggingv1.ReasonInvalid, "invalid pipelines are not forwarded: %v", unready))
+		status.Conditions.SetCondition(conditions.CondReady)
+		return nil, status
+	}
 	if len(unready) > 0 {
 		log.V(3).Info("validate clusterlogforwarder. Not all pipelines valid. Invalid CLF", "ForwarderSpec", clf.Spec)
 		status.Conditions.SetCondition(conditions.CondInvalid("invalid clf spec; one or more errors present: %v", unready))
@@ -67,6 +91,48 @@ func ValidateInputsOutputsPipelines(clf loggingv1.ClusterLogForwarder, k8sClient
 	return nil, status
 }
 
+// ReadyPipelines returns a copy of spec restricted to the pipelines that are ready in status,
+// and to the inputs, outputs and filters they reference.
+func ReadyPipelines(spec loggingv1.ClusterLogForwarderSpec, status loggingv1.ClusterLogForwarderStatus) loggingv1.ClusterLogForwarderSpec {
+	names := sets.NewString()
+	inputs, outputs, filters := sets.NewString(), sets.NewString(), sets.NewString()
+	pipelines := []loggingv1.PipelineSpec{}
+	for _, p := range spec.Pipelines {
+		if p.Name == "" || names.Has(p.Name) || !status.Pipelines[p.Name].IsTrueFor(loggingv1.ConditionReady) {
+			continue
+		}
+		names.Insert(p.Name)
+		inputs.Insert(p.InputRefs...)
+		outputs.Insert(p.OutputRefs...)
+		filters.Insert(p.FilterRefs...)
+		pipelines = append(pipelines, p)
+	}
+	spec.Pipelines = pipelines
+
+	in := []loggingv1.InputSpec{}
+	for _, i := range spec.Inputs {
+		if inputs.Has(i.Name) {
+			in = append(in, i)
+		}
+	}
+	spec.Inputs = in
+	out := []loggingv1.OutputSpec{}
+	for _, o := range spec.Outputs {
+		if outputs.Has(o.Name) {
+			out = append(out, o)
+		}
+	}
+	spec.Outputs = out
+	fs := []loggingv1.FilterSpec{}
+	for _, f := range spec.Filters {
+		if filters.Has(f.Name) {
+			fs = append(fs, f)
+		}
+	}
+	spec.Filters = fs
+	return spec
+}
+
 // verifyRefs returns the set of valid refs and a slice of error messages for bad refs.
 func verifyRefs(what, forwarderName string, status loggingv1.ClusterLogForwarderStatus, refs []string, allowed sets.String, required bool) (sets.String, []string) {
 
@@ -93,7 +159,7 @@ func verifyRefs(what, forwarderName string, status loggingv1.ClusterLogForwarder
 				bad.Insert(ref)
 			}
 		case "filters":
-			if allowed.Has(ref) {
+			if allowed.Has(ref) && status.Filters[ref].IsTrueFor(loggingv1.ConditionReady) {
 				good.Insert(ref)
 			} else {
 				bad.Insert(ref)
@@ -154,6 +220,11 @@ func verifyPipelines(forwarderName string, spec *loggingv1.ClusterLogForwarderSp
 			continue
 		}
 
+		if err := verifyJsonParsingToElasticsearch(spec, pipeline); err != nil {
+			status.Pipelines.Set(pipeline.Name, conditions.CondInvalid("%v", err))
+			continue
+		}
+
 		_, msgIn := verifyRefs("inputs", forwarderName, *status, pipeline.InputRefs, inputs, true)
 		_, msgOut := verifyRefs("outputs", forwarderName, *status, pipeline.OutputRefs, outputs, true)
 		_, msgFilter := verifyRefs("filters", forwarderName, *status, pipeline.FilterRefs, filters, false)
@@ -179,6 +250,7 @@ func verifyOutputs(namespace string, clfClient client.Client, spec *loggingv1.Cl
 
 	status.Outputs = loggingv1.NamedConditions{}
 	names := sets.NewString() // Collect pipeline names
+	awsRoleArn := ""          // The web identity role of the AWS outputs
 	for i, output := range spec.Outputs {
 		i, output := i, output // Don't bind range variable.
 		badName := func(format string, args ...interface{}) {
@@ -200,6 +272,11 @@ func verifyOutputs(namespace string, clfClient client.Client, spec *loggingv1.Cl
 		case !loggingv1.IsOutputTypeName(output.Type):
 			log.V(3).Info("verifyOutputs failed", "reason", "output type is invalid", "output name", output.Name, "output type", output.Type)
 			status.Outputs.Set(output.Name, conditions.CondInvalid("output %q: unknown output type %q", output.Name, output.Type))
+		case vectorOnlyOutputTypes.Has(output.Type) && !extras[constants.VectorName]:
+			log.V(3).Info("verifyOutputs failed", "reason", "output type is only supported by vector", "output name", output.Name, "output type", output.Type)
+			status.Outputs.Set(output.Name, conditions.CondInvalid("output %q: output type %q is only supported by the vector collector", output.Name, output.Type))
+		case output.ServiceAccountToken != nil && !verifyServiceAccountToken(&output, status.Outputs, extras):
+			log.V(3).Info("verifyOutputs failed", "reason", "service account token is invalid", "output name", output.Name)
 		case !verifyOutputURL(&output, status.Outputs):
 			log.V(3).Info("verifyOutputs failed", "reason", "output URL is invalid", "output URL", output.URL)
 		case !verifyOutputSecret(namespace, clfClient, &output, status.Outputs, extras):
@@ -207,6 +284,27 @@ func verifyOutputs(namespace string, clfClient client.Client, spec *loggingv1.Cl
 		case output.Type == loggingv1.OutputTypeCloudwatch && output.Cloudwatch == nil:
 			log.V(3).Info("verifyOutputs failed", "reason", "Cloudwatch output requires type spec", "output name", output.Name)
 			status.Outputs.Set(output.Name, conditions.CondInvalid("output %q: Cloudwatch output requires type spec", output.Name))
+		case output.Type == loggingv1.OutputTypeS3 && (output.S3 == nil || output.S3.Region == "" || output.S3.Bucket == ""):
+			log.V(3).Info("verifyOutputs failed", "reason", "S3 output requires region and bucket", "output name", output.Name)
+			status.Outputs.Set(output.Name, conditions.CondInvalid("output %q: S3 output requires region and bucket", output.Name))
+		case output.Type == loggingv1.OutputTypeAzureMonitor && (output.AzureMonitor == nil || output.AzureMonitor.CustomerId == "" || output.AzureMonitor.LogType == ""):
+			log.V(3).Info("verifyOutputs failed", "reason", "AzureMonitor output requires customerId and logType", "output name", output.Name)
+			status.Outputs.Set(output.Name, conditions.CondInvalid("output %q: AzureMonitor output requires customerId and logType", output.Name))
+		case output.Type == loggingv1.OutputTypeLokiStack && (output.LokiStack == nil || output.LokiStack.Name == ""):
+			log.V(3).Info("verifyOutputs failed", "reason", "LokiStack output requires name", "output name", output.Name)
+			status.Outputs.Set(output.Name, conditions.CondInvalid("output %q: LokiStack output requires name", output.Name))
+		case output.Type == loggingv1.OutputTypeS3 && !verifyS3KeyPrefix(&output, status.Outputs):
+			log.V(3).Info("verifyOutputs failed", "reason", "S3 key prefix is invalid", "output name", output.Name)
+		case output.Type == loggingv1.OutputTypeElasticsearch && !verifyElasticsearch(&output, status.Outputs, extras):
+			log.V(3).Info("verifyOutputs failed", "reason", "Elasticsearch spec is invalid", "output name", output.Name)
+		case output.Type == loggingv1.OutputTypeKafka && !verifyKafka(&output, status.Outputs, extras):
+			log.V(3).Info("verifyOutputs failed", "reason", "Kafka spec is invalid", "output name", output.Name)
+		case output.Type == loggingv1.OutputTypeLoki && !verifyLoki(&output, status.Outputs, extras):
+			log.V(3).Info("verifyOutputs failed", "reason", "Loki spec is invalid", "output name", output.Name)
+		case output.Type == loggingv1.OutputTypeSyslog && !verifySyslog(&output, status.Outputs, extras):
+			log.V(3).Info("verifyOutputs failed", "reason", "Syslog spec is invalid", "output name", output.Name)
+		case output.Type == loggingv1.OutputTypeHttp && !verifyHttp(&output, status.Outputs, extras):
+			log.V(3).Info("verifyOutputs failed", "reason", "Http spec is invalid", "output name", output.Name)
 		// Check googlecloudlogging specs, must only include one of the following
 		case output.Type == loggingv1.OutputTypeGoogleCloudLogging && output.GoogleCloudLogging != nil && !verifyGoogleCloudLogging(output.GoogleCloudLogging):
 			log.V(3).Info("verifyOutputs failed", "reason",
@@ -220,12 +318,21 @@ func verifyOutputs(namespace string, clfClient client.Client, spec *loggingv1.Cl
 			status.Outputs.Set(output.Name,
 				conditions.CondInvalid("output %q: Only one of indexKey or indexName can be set, not both.",
 					output.Name))
+		case output.Type == loggingv1.OutputTypeSplunk && !verifySplunk(&output, status.Outputs):
+			log.V(3).Info("verifyOutputs failed", "reason", "Splunk spec is invalid", "output name", output.Name)
+		case !verifyOutputTuning(&output, status.Outputs, extras):
+			log.V(3).Info("verifyOutputs failed", "reason", "output tuning is invalid", "output name", output.Name)
 		case output.HasPolicy() && output.GetMaxRecordsPerSecond() < 0:
 			status.Outputs.Set(output.Name, conditions.CondInvalid("output %q: Output cannot have negative limit threshold", output.Name))
 		case !outputRefs.Has(output.Name):
 			status.Outputs.Set(output.Name, conditions.CondInvalid("output %q: Output not referenced by any pipeline", output.Name))
+		case !verifyAWSRoleArn(namespace, clfClient, &output, status.Outputs, &awsRoleArn):
+			log.V(3).Info("verifyOutputs failed", "reason", "AWS role conflicts with the role of another output", "output name", output.Name)
 		default:
-			status.Outputs.Set(output.Name, conditions.CondReady)
+			// Keep a ready condition with a warning set while verifying the output
+			if !status.Outputs[output.Name].IsTrueFor(loggingv1.ConditionReady) {
+				status.Outputs.Set(output.Name, conditions.CondReady)
+			}
 		}
 
 		if output.Type == loggingv1.OutputTypeCloudwatch {
@@ -293,7 +400,9 @@ func verifyOutputURL(output *loggingv1.OutputSpec, conds loggingv1.NamedConditio
 		if output.URL == "" {
 			// Some output types allow a missing URL
 			// TODO (alanconway) move output-specific valiation to the output implementation.
-			if output.Type == loggingv1.OutputTypeCloudwatch || output.Type == loggingv1.OutputTypeGoogleCloudLogging {
+			if output.Type == loggingv1.OutputTypeCloudwatch || output.Type == loggingv1.OutputTypeS3 ||
+				output.Type == loggingv1.OutputTypeAzureMonitor || output.Type == loggingv1.OutputTypeGoogleCloudLogging ||
+				output.Type == loggingv1.OutputTypeLokiStack {
 				return true
 			} else {
 				return fail(conditions.CondInvalid("URL is required for output type %v", output.Type))
@@ -312,6 +421,12 @@ func verifyOutputURL(output *loggingv1.OutputSpec, conds loggingv1.NamedConditio
 				return fail(conditions.CondInvalid("invalid URL scheme: %v", u.Scheme))
 			}
 		}
+		if output.Type == loggingv1.OutputTypeOTLP {
+			scheme := strings.ToLower(u.Scheme)
+			if !(scheme == `http` || scheme == `https`) {
+				return fail(conditions.CondInvalid("invalid URL scheme: %v", u.Scheme))
+			}
+		}
 	}
 	return true
 }
@@ -323,10 +438,17 @@ func verifyOutputSecret(namespace string, clfClient client.Client, output *loggi
 	}
 
 	if output.Secret == nil {
-		if output.Type == loggingv1.OutputTypeCloudwatch || output.Type == loggingv1.OutputTypeSplunk {
+		if output.Type == loggingv1.OutputTypeCloudwatch || output.Type == loggingv1.OutputTypeS3 ||
+			output.Type == loggingv1.OutputTypeAzureMonitor || output.Type == loggingv1.OutputTypeSplunk {
 			return fail(conditions.CondMissing("secret must be provided for %s output", output.Type))
 		}
-		return true
+		if output.Type == loggingv1.OutputTypeKafka && output.Kafka != nil && output.Kafka.SASL != nil {
+			return fail(conditions.CondMissing("secret must be provided for %s output with sasl", output.Type))
+		}
+		if output.Type == loggingv1.OutputTypeElasticsearch && output.Elasticsearch != nil && output.Elasticsearch.Auth != nil {
+			return fail(conditions.CondMissing("secret must be provided for %s output with auth", output.Type))
+		}
+		return verifySecretKeysForTLS(namespace, clfClient, output, conds, nil, extras)
 	}
 
 	if output.Secret.Name == "" {
@@ -344,7 +466,7 @@ func verifyOutputSecret(namespace string, clfClient client.Client, output *loggi
 	}
 
 	switch output.Type {
-	case loggingv1.OutputTypeCloudwatch:
+	case loggingv1.OutputTypeCloudwatch, loggingv1.OutputTypeS3:
 		if !verifySecretKeysForCloudwatch(output, conds, secret) {
 			return false
 		}
@@ -352,8 +474,24 @@ func verifyOutputSecret(namespace string, clfClient client.Client, output *loggi
 		if !verifySecretKeysForSplunk(output, conds, secret) {
 			return false
 		}
+	case loggingv1.OutputTypeAzureMonitor:
+		if !verifySecretKeysForAzureMonitor(output, conds, secret) {
+			return false
+		}
+	case loggingv1.OutputTypeLokiStack:
+		if !verifySecretKeysForLokiStack(output, conds, secret) {
+			return false
+		}
+	case loggingv1.OutputTypeElasticsearch:
+		if !verifySecretKeysForElasticsearch(output, conds, secret) {
+			return false
+		}
+	case loggingv1.OutputTypeKafka:
+		if !verifySecretKeysForKafka(output, conds, secret) {
+			return false
+		}
 	}
-	return verifySecretKeysForTLS(output, conds, secret)
+	return verifySecretKeysForTLS(namespace, clfClient, output, conds, secret, extras)
 }
 
 func getOutputSecret(namespace string, clfClient client.Client, secretName string) (*corev1.Secret, error) {
@@ -366,12 +504,46 @@ func getOutputSecret(namespace string, clfClient client.Client, secretName strin
 	return secret, err
 }
 
-func verifySecretKeysForTLS(output *loggingv1.OutputSpec, conds loggingv1.NamedConditions, secret *corev1.Secret) bool {
+func verifySecretKeysForTLS(namespace string, clfClient client.Client, output *loggingv1.OutputSpec, conds loggingv1.NamedConditions, secret *corev1.Secret, extras map[string]bool) bool {
 	fail := func(c status.Condition) bool {
 		conds.Set(output.Name, c)
 		return false
 	}
 
+	// Make sure the TLS values referenced independently of the output secret exist.
+	if tls := output.TLS; tls.HasReferences() {
+		if !extras[constants.VectorName] {
+			return fail(conditions.CondInvalid("TLS references are only supported by the vector collector"))
+		}
+		switch {
+		case tls.Certificate != nil && tls.Key == nil:
+			return fail(conditions.CondMissing("cannot have %v without %v", "tls.certificate", "tls.key"))
+		case tls.Certificate == nil && tls.Key != nil:
+			return fail(conditions.CondMissing("cannot have %v without %v", "tls.key", "tls.certificate"))
+		case tls.KeyPassphrase != nil && tls.Key == nil && !common.HasKeys(secret, constants.ClientPrivateKey):
+			return fail(conditions.CondMissing("cannot have %v without %v", "tls.keyPassphrase", "tls.key"))
+		}
+		for _, r := range []struct {
+			field string
+			ref   *loggingv1.ValueReference
+		}{
+			{"tls.ca", tls.CA},
+			{"tls.certificate", tls.Certificate},
+			{"tls.key", tls.Key.ValueReference()},
+			{"tls.keyPassphrase", tls.KeyPassphrase.ValueReference()},
+		} {
+			if r.ref == nil {
+				continue
+			}
+			if cond, ok := verifyTLSReference(namespace, clfClient, r.field, *r.ref); !ok {
+				return fail(cond)
+			}
+		}
+	}
+	if secret == nil {
+		return true
+	}
+
 	// Make sure we have secrets for a valid TLS configuration.
 	haveCert := len(secret.Data[constants.ClientCertKey]) > 0
 	haveKey := len(secret.Data[constants.ClientPrivateKey]) > 0
@@ -390,6 +562,35 @@ func verifySecretKeysForTLS(output *loggingv1.OutputSpec, conds loggingv1.NamedC
 	return true
 }
 
+// verifyTLSReference verifies a referenced configmap or secret exists and holds the key
+func verifyTLSReference(namespace string, clfClient client.Client, field string, ref loggingv1.ValueReference) (status.Condition, bool) {
+	switch {
+	case ref.Key == "":
+		return conditions.CondInvalid("%s: key is required", field), false
+	case (ref.ConfigMapName == "") == (ref.SecretName == ""):
+		return conditions.CondInvalid("%s: exactly one of configMapName or secretName is required", field), false
+	case ref.ConfigMapName != "":
+		configMap := &corev1.ConfigMap{}
+		if err := clfClient.Get(context.TODO(), types.NamespacedName{Name: ref.ConfigMapName, Namespace: namespace}, configMap); err != nil {
+			return conditions.CondMissing("%s: configmap %q not found", field, ref.ConfigMapName), false
+		}
+		_, hasData := configMap.Data[ref.Key]
+		_, hasBinaryData := configMap.BinaryData[ref.Key]
+		if !hasData && !hasBinaryData {
+			return conditions.CondMissing("%s: key %q not found in configmap %q", field, ref.Key, ref.ConfigMapName), false
+		}
+	default:
+		secret, err := getOutputSecret(namespace, clfClient, ref.SecretName)
+		if err != nil {
+			return conditions.CondMissing("%s: secret %q not found", field, ref.SecretName), false
+		}
+		if len(secret.Data[ref.Key]) == 0 {
+			return conditions.CondMissing("%s: key %q not found in secret %q", field, ref.Key, ref.SecretName), false
+		}
+	}
+	return status.Condition{}, true
+}
+
 func verifySecretKeysForCloudwatch(output *loggingv1.OutputSpec, conds loggingv1.NamedConditions, secret *corev1.Secret) bool {
 	fail := func(c status.Condition) bool {
 		conds.Set(output.Name, c)
@@ -413,6 +614,354 @@ func verifySecretKeysForCloudwatch(output *loggingv1.OutputSpec, conds loggingv1
 	return true
 }
 
+// verifyAWSRoleArn verifies the web identity role of a cloudwatch, s3 or elasticsearch with aws auth output
+// is the role of the previous AWS outputs. The collector assumes a single role, set in its environment, for all of them
+func verifyAWSRoleArn(namespace string, clfClient client.Client, output *loggingv1.OutputSpec, conds loggingv1.NamedConditions, roleArn *string) bool {
+	isAWS := output.Type == loggingv1.OutputTypeCloudwatch || output.Type == loggingv1.OutputTypeS3 ||
+		(output.Type == loggingv1.OutputTypeElasticsearch && output.Elasticsearch != nil &&
+			output.Elasticsearch.Auth != nil && output.Elasticsearch.Auth.Type == loggingv1.ElasticsearchAuthTypeAWS)
+	if !isAWS || output.Secret == nil {
+		return true
+	}
+	secret, err := getOutputSecret(namespace, clfClient, output.Secret.Name)
+	if err != nil { // reported by verifyOutputSecret()
+		return true
+	}
+	arn := cloudwatch.ParseRoleArn(secret)
+	switch {
+	case arn == "":
+		return true
+	case *roleArn == "":
+		*roleArn = arn
+		return true
+	case arn != *roleArn:
+		conds.Set(output.Name, conditions.CondInvalid("output %q: role %q conflicts with role %q of another output, all AWS outputs must use the same role", output.Name, arn, *roleArn))
+		return false
+	}
+	return true
+}
+
+func verifyS3KeyPrefix(output *loggingv1.OutputSpec, conds loggingv1.NamedConditions) bool {
+	if output.S3.KeyPrefix == "" {
+		return true
+	}
+	if _, err := helpers.ParseTemplate(output.S3.KeyPrefix); err != nil {
+		conds.Set(output.Name, conditions.CondInvalid("output %q: invalid keyPrefix: %v", output.Name, err))
+		return false
+	}
+	return true
+}
+
+func verifyKafka(output *loggingv1.OutputSpec, conds loggingv1.NamedConditions, extras map[string]bool) bool {
+	if output.Kafka == nil {
+		return true
+	}
+	kafka := output.Kafka
+	if (kafka.RequiredAcks != nil || kafka.Compression != "" || kafka.MaxMessageBytes != nil || kafka.Idempotent) && !extras[constants.VectorName] {
+		conds.Set(output.Name, conditions.CondInvalid("output %q: requiredAcks, compression, maxMessageBytes and idempotent are only supported by the vector collector", output.Name))
+		return false
+	}
+	if kafka.Idempotent && kafka.RequiredAcks != nil && *kafka.RequiredAcks != -1 {
+		conds.Set(output.Name, conditions.CondInvalid("output %q: idempotent requires requiredAcks -1", output.Name))
+		return false
+	}
+	if kafka.SASL != nil && kafka.SASL.Mechanism != "" && !kafkaSASLMechanisms.Has(kafka.SASL.Mechanism) {
+		if strings.Contains(kafka.SASL.Mechanism, ",") {
+			// librdkafka does not support multiple values for sasl mechanism
+			conds.Set(output.Name, conditions.CondInvalid("output %q: sasl mechanism %q must be a single value", output.Name, kafka.SASL.Mechanism))
+			return false
+		}
+		conds.Set(output.Name, conditions.CondInvalid("output %q: unsupported sasl mechanism %q, must be one of %v", output.Name, kafka.SASL.Mechanism, kafkaSASLMechanisms.List()))
+		return false
+	}
+	if (helpers.IsTemplate(output.Kafka.Topic) || output.Kafka.Key != "") && !extras[constants.VectorName] {
+		conds.Set(output.Name, conditions.CondInvalid("output %q: topic and key templates are only supported by the vector collector", output.Name))
+		return false
+	}
+	if err := helpers.VerifyTemplate(output.Kafka.Topic); err != nil {
+		conds.Set(output.Name, conditions.CondInvalid("output %q: invalid topic: %v", output.Name, err))
+		return false
+	}
+	if err := helpers.VerifyTemplate(output.Kafka.Key); err != nil {
+		conds.Set(output.Name, conditions.CondInvalid("output %q: invalid key: %v", output.Name, err))
+		return false
+	}
+	return true
+}
+
+func verifyElasticsearch(output *loggingv1.OutputSpec, conds loggingv1.NamedConditions, extras map[string]bool) bool {
+	es := output.Elasticsearch
+	if es == nil {
+		return true
+	}
+	fail := func(c status.Condition) bool {
+		conds.Set(output.Name, c)
+		return false
+	}
+	if (es.BulkMode != "" || es.DataStream != nil || es.Pipeline != "" || es.IDField != "") && !extras[constants.VectorName] {
+		return fail(conditions.CondInvalid("output %q: bulkMode, dataStream, pipeline and idField are only supported by the vector collector", output.Name))
+	}
+	if es.Auth != nil {
+		if !extras[constants.VectorName] {
+			return fail(conditions.CondInvalid("output %q: auth is only supported by the vector collector", output.Name))
+		}
+		if es.Auth.Type == loggingv1.ElasticsearchAuthTypeAWS && es.Auth.Region == "" {
+			return fail(conditions.CondInvalid("output %q: auth type %s requires region", output.Name, loggingv1.ElasticsearchAuthTypeAWS))
+		}
+	}
+	if es.IDField != "" {
+		if err := helpers.VerifyFieldPath(es.IDField); err != nil {
+			return fail(conditions.CondInvalid("output %q: invalid idField: %v", output.Name, err))
+		}
+	}
+	if es.BulkMode != loggingv1.ElasticsearchBulkModeDataStream {
+		if es.DataStream != nil {
+			return fail(conditions.CondInvalid("output %q: dataStream requires bulkMode %s", output.Name, loggingv1.ElasticsearchBulkModeDataStream))
+		}
+		return true
+	}
+	if es.Version < 7 {
+		return fail(conditions.CondInvalid("output %q: bulkMode %s requires Elasticsearch version 7 or later", output.Name, loggingv1.ElasticsearchBulkModeDataStream))
+	}
+	if ds := es.DataStream; ds != nil {
+		for _, part := range []struct{ name, template string }{
+			{"dataStream.type", ds.Type},
+			{"dataStream.dataset", ds.Dataset},
+			{"dataStream.namespace", ds.Namespace},
+		} {
+			if err := helpers.VerifyTemplate(part.template); err != nil {
+				return fail(conditions.CondInvalid("output %q: invalid %s: %v", output.Name, part.name, err))
+			}
+		}
+	}
+	return true
+}
+
+func verifyLoki(output *loggingv1.OutputSpec, conds loggingv1.NamedConditions, extras map[string]bool) bool {
+	l := output.Loki
+	if l == nil {
+		return true
+	}
+	fail := func(c status.Condition) bool {
+		conds.Set(output.Name, c)
+		return false
+	}
+	if (len(l.PodLabelKeys) > 0 || l.MaxLabelValues != 0) && !extras[constants.VectorName] {
+		return fail(conditions.CondInvalid("output %q: podLabelKeys and maxLabelValues are only supported by the vector collector", output.Name))
+	}
+	for _, glob := range l.PodLabelKeys {
+		if err := loki.VerifyPodLabelGlob(glob); err != nil {
+			return fail(conditions.CondInvalid("output %q: %v", output.Name, err))
+		}
+	}
+	names := map[string]string{}
+	for _, key := range l.LabelKeys {
+		name := loki.LabelName(key)
+		if other, found := names[name]; found && other != key {
+			return fail(conditions.CondInvalid("output %q: label keys %q and %q have the same label name %q", output.Name, other, key, name))
+		}
+		names[name] = key
+		if podLabel := strings.TrimPrefix(key, "kubernetes.labels."); podLabel != key {
+			for _, glob := range l.PodLabelKeys {
+				if loki.MatchesPodLabelGlob(glob, podLabel) {
+					return fail(conditions.CondInvalid("output %q: label key %q is also selected by pod label key glob %q", output.Name, key, glob))
+				}
+			}
+		}
+	}
+	if l.AllowUnboundedLabels {
+		return true
+	}
+	for _, glob := range l.PodLabelKeys {
+		for _, key := range loki.UnboundedPodLabels {
+			if loki.MatchesPodLabelGlob(glob, key) {
+				return fail(conditions.CondInvalid("output %q: pod label key glob %q selects %q which has unbounded values and requires allowUnboundedLabels", output.Name, glob, key))
+			}
+		}
+	}
+	// Label keys with unbounded values were accepted before, only warn to keep existing forwarders valid
+	unbounded := sets.NewString(loki.UnboundedLabelKeys...)
+	for _, key := range l.LabelKeys {
+		if unbounded.Has(key) {
+			conds.Set(output.Name, conditions.CondReadyWithMessage(loggingv1.ReasonUnboundedLabels,
+				"output %q: label key %q has unbounded values which can create a high number of Loki streams", output.Name, key))
+			return true
+		}
+	}
+	return true
+}
+
+// syslogSDNameRegex matches SD-IDs and PARAM-NAMEs: printable US-ASCII except '=', SP, ']' and '"'
+var syslogSDNameRegex = regexp.MustCompile(`^[!#-<>-\\^-~]{1,32}$`)
+
+func verifySyslog(output *loggingv1.OutputSpec, conds loggingv1.NamedConditions, extras map[string]bool) bool {
+	s := output.Syslog
+	if s == nil {
+		return true
+	}
+	fail := func(c status.Condition) bool {
+		conds.Set(output.Name, c)
+		return false
+	}
+	if (s.StructuredData != nil || s.Framing != "" || s.MaxMessageSize != 0) && !extras[constants.VectorName] {
+		return fail(conditions.CondInvalid("output %q: structuredData, framing and maxMessageSize are only supported by the vector collector", output.Name))
+	}
+	udp := false
+	if u, err := url.Parse(output.URL); err == nil {
+		udp = strings.ToLower(u.Scheme) == syslog.UDP
+	}
+	if s.Framing != "" && s.Framing != loggingv1.SyslogFramingNewline && s.Framing != loggingv1.SyslogFramingOctetCounting {
+		return fail(conditions.CondInvalid("output %q: unsupported framing %q, must be one of %s, %s", output.Name, s.Framing, loggingv1.SyslogFramingNewline, loggingv1.SyslogFramingOctetCounting))
+	}
+	if s.Framing != "" && udp {
+		return fail(conditions.CondInvalid("output %q: framing is only supported for tcp and tls", output.Name))
+	}
+	rfc5424 := s.RFC == "" || strings.ToLower(s.RFC) == syslog.RFC5424
+	if s.Framing == loggingv1.SyslogFramingOctetCounting && !rfc5424 {
+		return fail(conditions.CondInvalid("output %q: framing %s requires rfc RFC5424", output.Name, loggingv1.SyslogFramingOctetCounting))
+	}
+	if (s.StructuredData != nil || s.Framing == loggingv1.SyslogFramingOctetCounting) && s.AddLogSource {
+		return fail(conditions.CondInvalid("output %q: addLogSource can not be used with structuredData or framing %s", output.Name, loggingv1.SyslogFramingOctetCounting))
+	}
+	if s.MaxMessageSize != 0 && !udp {
+		return fail(conditions.CondInvalid("output %q: maxMessageSize is only supported for udp", output.Name))
+	}
+	if sd := s.StructuredData; sd != nil {
+		if !rfc5424 {
+			return fail(conditions.CondInvalid("output %q: structuredData requires rfc RFC5424", output.Name))
+		}
+		if !syslogSDNameRegex.MatchString(sd.ID) {
+			return fail(conditions.CondInvalid("output %q: invalid structuredData id %q", output.Name, sd.ID))
+		}
+		if len(sd.Params) == 0 {
+			return fail(conditions.CondInvalid("output %q: structuredData requires params", output.Name))
+		}
+		for name := range sd.Params {
+			if !syslogSDNameRegex.MatchString(name) {
+				return fail(conditions.CondInvalid("output %q: invalid structuredData param name %q", output.Name, name))
+			}
+		}
+	}
+	return true
+}
+
+var serviceAccountTokenOutputTypes = sets.NewString(loggingv1.OutputTypeHttp, loggingv1.OutputTypeLoki, loggingv1.OutputTypeElasticsearch)
+
+func verifyServiceAccountToken(output *loggingv1.OutputSpec, conds loggingv1.NamedConditions, extras map[string]bool) bool {
+	fail := func(c status.Condition) bool {
+		conds.Set(output.Name, c)
+		return false
+	}
+	if !serviceAccountTokenOutputTypes.Has(output.Type) {
+		return fail(conditions.CondInvalid("output %q: serviceAccountToken is only supported by %s outputs", output.Name, strings.Join(serviceAccountTokenOutputTypes.List(), ", ")))
+	}
+	if !extras[constants.VectorName] {
+		return fail(conditions.CondInvalid("output %q: serviceAccountToken is only supported by the vector collector", output.Name))
+	}
+	if output.Type == loggingv1.OutputTypeElasticsearch && output.Elasticsearch != nil && output.Elasticsearch.Auth != nil {
+		return fail(conditions.CondInvalid("output %q: serviceAccountToken and auth cannot be used together", output.Name))
+	}
+	if exp := output.ServiceAccountToken.ExpirationSeconds; exp != nil && *exp < 600 {
+		return fail(conditions.CondInvalid("output %q: serviceAccountToken expirationSeconds must be at least 600", output.Name))
+	}
+	return true
+}
+
+func verifyHttp(output *loggingv1.OutputSpec, conds loggingv1.NamedConditions, extras map[string]bool) bool {
+	h := output.Http
+	if h == nil {
+		return true
+	}
+	fail := func(c status.Condition) bool {
+		conds.Set(output.Name, c)
+		return false
+	}
+	if (h.Format != "" || h.TextField != "") && !extras[constants.VectorName] {
+		return fail(conditions.CondInvalid("output %q: format and textField are only supported by the vector collector", output.Name))
+	}
+	if h.TextField != "" {
+		if h.Format != loggingv1.HttpFormatText {
+			return fail(conditions.CondInvalid("output %q: textField requires format %s", output.Name, loggingv1.HttpFormatText))
+		}
+		if err := helpers.VerifyFieldPath(h.TextField); err != nil {
+			return fail(conditions.CondInvalid("output %q: invalid textField: %v", output.Name, err))
+		}
+	}
+	return verifyHttpContentTypeHeaders(output, conds, extras)
+}
+
+func verifySplunk(output *loggingv1.OutputSpec, conds loggingv1.NamedConditions) bool {
+	s := output.Splunk
+	if s == nil {
+		return true
+	}
+	fail := func(c status.Condition) bool {
+		conds.Set(output.Name, c)
+		return false
+	}
+	for _, field := range []struct{ name, template string }{
+		{"source", s.Source},
+		{"sourceType", s.SourceType},
+		{"host", s.Host},
+	} {
+		if field.template == "" {
+			continue
+		}
+		if err := helpers.VerifyTemplate(field.template); err != nil {
+			return fail(conditions.CondInvalid("output %q: invalid %s: %v", output.Name, field.name, err))
+		}
+	}
+	if acks := s.Acknowledgements; acks != nil && !acks.Enabled && (acks.QueryInterval != 0 || acks.RetryLimit != 0) {
+		return fail(conditions.CondInvalid("output %q: acknowledgements queryInterval and retryLimit require acknowledgements to be enabled", output.Name))
+	}
+	return true
+}
+
+func verifySecretKeysForLokiStack(output *loggingv1.OutputSpec, conds loggingv1.NamedConditions, secret *corev1.Secret) bool {
+	if !common.HasBearerTokenFileKey(secret) {
+		conds.Set(output.Name, conditions.CondMissing("auth keys: "+constants.BearerTokenFileKey+" is required"))
+		return false
+	}
+	return true
+}
+
+func verifySecretKeysForElasticsearch(output *loggingv1.OutputSpec, conds loggingv1.NamedConditions, secret *corev1.Secret) bool {
+	if output.Elasticsearch == nil || output.Elasticsearch.Auth == nil {
+		return true
+	}
+	switch output.Elasticsearch.Auth.Type {
+	case loggingv1.ElasticsearchAuthTypeAWS:
+		return verifySecretKeysForCloudwatch(output, conds, secret)
+	case loggingv1.ElasticsearchAuthTypeAPIKey:
+		if len(secret.Data[constants.ElasticsearchAPIKey]) == 0 {
+			conds.Set(output.Name, conditions.CondMissing("auth keys: "+constants.ElasticsearchAPIKey+" is required"))
+			return false
+		}
+	}
+	return true
+}
+
+func verifySecretKeysForKafka(output *loggingv1.OutputSpec, conds loggingv1.NamedConditions, secret *corev1.Secret) bool {
+	if output.Kafka != nil && output.Kafka.SASL != nil && !common.HasUsernamePassword(secret) {
+		conds.Set(output.Name, conditions.CondMissing("auth keys: sasl requires %s and %s", constants.ClientUsername, constants.ClientPassword))
+		return false
+	}
+	return true
+}
+
+func verifySecretKeysForAzureMonitor(output *loggingv1.OutputSpec, conds loggingv1.NamedConditions, secret *corev1.Secret) bool {
+	fail := func(c status.Condition) bool {
+		conds.Set(output.Name, c)
+		return false
+	}
+
+	// Ensure we have the workspace key for a valid azure monitor config
+	if len(secret.Data[constants.SharedKey]) == 0 {
+		return fail(conditions.CondMissing("auth keys: " + constants.SharedKey + " is required"))
+	}
+	return true
+}
+
 func verifySecretKeysForSplunk(output *loggingv1.OutputSpec, conds loggingv1.NamedConditions, secret *corev1.Secret) bool {
 	fail := func(c status.Condition) bool {
 		conds.Set(output.Name, c)
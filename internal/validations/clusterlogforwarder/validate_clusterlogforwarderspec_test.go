@@ -18,6 +18,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
 	"github.com/openshift/cluster-logging-operator/internal/status"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 
 	. "github.com/openshift/cluster-logging-operator/test"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
@@ -209,6 +210,306 @@ var _ = Describe("Validate clusterlogforwarderspec", func() {
 			Expect(clfStatus.Outputs["aName"]).To(HaveCondition("Ready", false, "Invalid", "unknown.*\"foo\""))
 		})
 
+		Context("for output type options", func() {
+			// with returns a copy of output modified by mutate
+			with := func(output loggingv1.OutputSpec, mutate func(o *loggingv1.OutputSpec)) loggingv1.OutputSpec {
+				o := *output.DeepCopy()
+				mutate(&o)
+				return o
+			}
+			otlp := loggingv1.OutputSpec{Name: "otlp", Type: loggingv1.OutputTypeOTLP, URL: "http://collector:4318"}
+			s3 := loggingv1.OutputSpec{
+				Name:           "s3",
+				Type:           loggingv1.OutputTypeS3,
+				OutputTypeSpec: loggingv1.OutputTypeSpec{S3: &loggingv1.S3{Region: "us-east-1", Bucket: "archive"}},
+				Secret:         &loggingv1.OutputSecretSpec{Name: secretName},
+			}
+			azure := loggingv1.OutputSpec{
+				Name:           "azure",
+				Type:           loggingv1.OutputTypeAzureMonitor,
+				OutputTypeSpec: loggingv1.OutputTypeSpec{AzureMonitor: &loggingv1.AzureMonitor{CustomerId: "my-workspace", LogType: "openshift_logs"}},
+				Secret:         &loggingv1.OutputSecretSpec{Name: "azure-secret"},
+			}
+			kafkaTemplates := loggingv1.OutputSpec{
+				Name: "kafka",
+				Type: loggingv1.OutputTypeKafka,
+				URL:  "tcp://broker.kafka.svc:9092",
+				OutputTypeSpec: loggingv1.OutputTypeSpec{Kafka: &loggingv1.Kafka{
+					Topic: `app-{.kubernetes.namespace_name||"none"}`,
+					Key:   `{.kubernetes.pod_name||"none"}`,
+				}},
+			}
+			kafkaSASL := loggingv1.OutputSpec{
+				Name: "kafka",
+				Type: loggingv1.OutputTypeKafka,
+				URL:  "tls://broker.kafka.svc:9093/topic",
+				OutputTypeSpec: loggingv1.OutputTypeSpec{Kafka: &loggingv1.Kafka{
+					SASL:            &loggingv1.KafkaSASL{Mechanism: loggingv1.KafkaSASLMechanismScramSHA512},
+					RequiredAcks:    utils.GetPtr(int32(1)),
+					MaxMessageBytes: utils.GetPtr(int64(1000000)),
+				}},
+				Secret: &loggingv1.OutputSecretSpec{Name: "kafka-secret"},
+			}
+			esBulk := loggingv1.OutputSpec{
+				Name: "es",
+				Type: loggingv1.OutputTypeElasticsearch,
+				URL:  "https://es.svc:9200",
+				OutputTypeSpec: loggingv1.OutputTypeSpec{Elasticsearch: &loggingv1.Elasticsearch{
+					Version:    8,
+					BulkMode:   loggingv1.ElasticsearchBulkModeDataStream,
+					DataStream: &loggingv1.ElasticsearchDataStream{Namespace: `{.kubernetes.namespace_name||"none"}`},
+					Pipeline:   "openshift-logs",
+					IDField:    ".viaq_msg_id",
+				}},
+			}
+			esAuth := loggingv1.OutputSpec{
+				Name: "es",
+				Type: loggingv1.OutputTypeElasticsearch,
+				URL:  "https://search-logs.us-west-2.es.amazonaws.com",
+				OutputTypeSpec: loggingv1.OutputTypeSpec{Elasticsearch: &loggingv1.Elasticsearch{
+					Auth: &loggingv1.ElasticsearchAuth{Type: loggingv1.ElasticsearchAuthTypeAWS, Region: "us-west-2"},
+				}},
+				Secret: &loggingv1.OutputSecretSpec{Name: secretName},
+			}
+			esToken := loggingv1.OutputSpec{
+				Name:                "es",
+				Type:                loggingv1.OutputTypeElasticsearch,
+				URL:                 "https://es.example.com:9200",
+				ServiceAccountToken: &loggingv1.ServiceAccountToken{Audience: "es", ExpirationSeconds: utils.GetPtr[int64](3600)},
+			}
+			loki := loggingv1.OutputSpec{
+				Name: "loki",
+				Type: loggingv1.OutputTypeLoki,
+				URL:  "https://loki.svc:3100",
+				OutputTypeSpec: loggingv1.OutputTypeSpec{Loki: &loggingv1.Loki{
+					LabelKeys:      []string{"log_type", "kubernetes.namespace_name"},
+					PodLabelKeys:   []string{"app.kubernetes.io/*", "team"},
+					MaxLabelValues: 50,
+				}},
+			}
+			lokiStack := loggingv1.OutputSpec{
+				Name:           "lokistack",
+				Type:           loggingv1.OutputTypeLokiStack,
+				OutputTypeSpec: loggingv1.OutputTypeSpec{LokiStack: &loggingv1.LokiStack{Name: "logging-loki", Namespace: constants.OpenshiftNS}},
+				Secret:         &loggingv1.OutputSecretSpec{Name: "collector-token"},
+			}
+			syslog := loggingv1.OutputSpec{
+				Name: "syslog",
+				Type: loggingv1.OutputTypeSyslog,
+				URL:  "udp://syslog.example.com:514",
+				OutputTypeSpec: loggingv1.OutputTypeSpec{Syslog: &loggingv1.Syslog{
+					RFC: "RFC5424",
+					StructuredData: &loggingv1.SyslogStructuredData{
+						ID:     "openshift@2312",
+						Params: map[string]string{"namespace": "$.kubernetes.namespace_name"},
+					},
+					MaxMessageSize: 1024,
+				}},
+			}
+			http := loggingv1.OutputSpec{
+				Name: "http",
+				Type: loggingv1.OutputTypeHttp,
+				URL:  "https://my-logstore.com",
+				OutputTypeSpec: loggingv1.OutputTypeSpec{Http: &loggingv1.Http{
+					Format:    loggingv1.HttpFormatText,
+					TextField: ".structured.line",
+				}},
+			}
+
+			// An empty reason expects the output to be ready
+			DescribeTable("should verify the options of the output type", func(output loggingv1.OutputSpec, vector bool, reason, message string) {
+				client = fake.NewFakeClient(cloudWatchSecret, //nolint
+					runtime.NewSecret(constants.OpenshiftNS, "azure-secret", map[string][]byte{constants.SharedKey: []byte("z9uEBqblq5jAhPbHZ8nq8A==")}),
+					runtime.NewSecret(constants.OpenshiftNS, "es-secret", map[string][]byte{constants.ElasticsearchAPIKey: []byte("a2V5OnNlY3JldA==")}),
+					runtime.NewSecret(constants.OpenshiftNS, "collector-token", map[string][]byte{constants.BearerTokenFileKey: []byte("sa-token")}),
+					runtime.NewSecret(constants.OpenshiftNS, "kafka-secret", map[string][]byte{
+						constants.ClientUsername: []byte("user"),
+						constants.ClientPassword: []byte("pass"),
+					}),
+				)
+				forwarderSpec.Pipelines = []loggingv1.PipelineSpec{{OutputRefs: []string{output.Name}}}
+				forwarderSpec.Outputs = []loggingv1.OutputSpec{output}
+				extras[constants.VectorName] = vector
+				verifyOutputs(namespace, client, forwarderSpec, clfStatus, extras)
+				if reason == "" {
+					Expect(clfStatus.Outputs[output.Name]).To(HaveCondition("Ready", true, "", ""))
+				} else {
+					Expect(clfStatus.Outputs[output.Name]).To(HaveCondition("Ready", false, loggingv1.ConditionReason(reason), message))
+				}
+			},
+				Entry("OTLP with an http URL", otlp, true, "", ""),
+				Entry("OTLP with a non http URL", with(otlp, func(o *loggingv1.OutputSpec) { o.URL = "tcp://collector:4317" }), true,
+					"Invalid", "invalid URL scheme: tcp"),
+				Entry("OTLP if the collector is not vector", otlp, false, "Invalid", `output type "otlp" is only supported by the vector collector`),
+
+				Entry("S3 without a URL", s3, true, "", ""),
+				Entry("S3 with a key prefix template", with(s3, func(o *loggingv1.OutputSpec) {
+					o.S3.KeyPrefix = `{.log_type}/{.kubernetes.namespace_name||"none"}/%Y-%m-%d/`
+				}), true, "", ""),
+				Entry("S3 with an invalid key prefix template", with(s3, func(o *loggingv1.OutputSpec) { o.S3.KeyPrefix = "{log_type}/" }), true,
+					"Invalid", `invalid keyPrefix: template "{log_type}/" has an invalid field path "log_type"`),
+				Entry("S3 without a bucket", with(s3, func(o *loggingv1.OutputSpec) { o.S3.Bucket = "" }), true,
+					"Invalid", "S3 output requires region and bucket"),
+				Entry("S3 without a secret", with(s3, func(o *loggingv1.OutputSpec) { o.Secret = nil }), true,
+					"MissingResource", "secret must be provided for s3 output"),
+				Entry("S3 if the collector is not vector", s3, false, "Invalid", `output type "s3" is only supported by the vector collector`),
+
+				Entry("AzureMonitor without a URL", azure, true, "", ""),
+				Entry("AzureMonitor without a customerId", with(azure, func(o *loggingv1.OutputSpec) { o.AzureMonitor.CustomerId = "" }), true,
+					"Invalid", "AzureMonitor output requires customerId and logType"),
+				Entry("AzureMonitor without a secret", with(azure, func(o *loggingv1.OutputSpec) { o.Secret = nil }), true,
+					"MissingResource", "secret must be provided for azureMonitor output"),
+				Entry("AzureMonitor when the secret has no shared_key", with(azure, func(o *loggingv1.OutputSpec) { o.Secret.Name = secretName }), true,
+					"MissingResource", "auth keys: shared_key is required"),
+				Entry("AzureMonitor if the collector is not vector", azure, false, "Invalid", `output type "azureMonitor" is only supported by the vector collector`),
+
+				Entry("Kafka templates with known fields and fallbacks", kafkaTemplates, true, "", ""),
+				Entry("Kafka with an unknown field in the topic", with(kafkaTemplates, func(o *loggingv1.OutputSpec) { o.Kafka.Topic = `app-{.namespace||"none"}` }), true,
+					"Invalid", `invalid topic: .* references unknown field ".namespace"`),
+				Entry("Kafka with a missing fallback in the key", with(kafkaTemplates, func(o *loggingv1.OutputSpec) { o.Kafka.Key = "{.kubernetes.pod_name}" }), true,
+					"Invalid", `invalid key: .* requires a fallback value for field ".kubernetes.pod_name"`),
+				Entry("Kafka templates if the collector is not vector", kafkaTemplates, false,
+					"Invalid", "topic and key templates are only supported by the vector collector"),
+
+				Entry("Kafka SASL with username and password in the secret", kafkaSASL, true, "", ""),
+				Entry("Kafka SASL without a secret", with(kafkaSASL, func(o *loggingv1.OutputSpec) { o.Secret = nil }), true,
+					"MissingResource", "secret must be provided for kafka output with sasl"),
+				Entry("Kafka SASL without username and password", with(kafkaSASL, func(o *loggingv1.OutputSpec) { o.Secret.Name = secretName }), true,
+					"MissingResource", "auth keys: sasl requires username and password"),
+				Entry("Kafka producer options if the collector is not vector", kafkaSASL, false,
+					"Invalid", "requiredAcks, compression, maxMessageBytes and idempotent are only supported by the vector collector"),
+				Entry("Kafka with a list of SASL mechanisms migrated from the secret", with(kafkaSASL, func(o *loggingv1.OutputSpec) { o.Kafka.SASL.Mechanism = "PLAIN,SCRAM-SHA-256" }), true,
+					"Invalid", `sasl mechanism "PLAIN,SCRAM-SHA-256" must be a single value`),
+				Entry("Kafka with an unsupported SASL mechanism", with(kafkaSASL, func(o *loggingv1.OutputSpec) { o.Kafka.SASL.Mechanism = "GSSAPI" }), true,
+					"Invalid", `unsupported sasl mechanism "GSSAPI"`),
+				Entry("Kafka with an idempotent producer acknowledged by all replicas", with(kafkaSASL, func(o *loggingv1.OutputSpec) {
+					o.Kafka.Idempotent = true
+					o.Kafka.RequiredAcks = utils.GetPtr(int32(-1))
+				}), true, "", ""),
+				Entry("Kafka with an idempotent producer acknowledged by the leader only", with(kafkaSASL, func(o *loggingv1.OutputSpec) { o.Kafka.Idempotent = true }), true,
+					"Invalid", "idempotent requires requiredAcks -1"),
+
+				Entry("Elasticsearch with data stream templates and an ID field", esBulk, true, "", ""),
+				Entry("Elasticsearch with an invalid data stream template", with(esBulk, func(o *loggingv1.OutputSpec) { o.Elasticsearch.DataStream.Dataset = "{.log_type}" }), true,
+					"Invalid", `invalid dataStream.dataset: .* requires a fallback value for field ".log_type"`),
+				Entry("Elasticsearch with an unknown ID field", with(esBulk, func(o *loggingv1.OutputSpec) { o.Elasticsearch.IDField = ".msg_id" }), true,
+					"Invalid", `invalid idField: unknown field ".msg_id"`),
+				Entry("Elasticsearch 6 with a data stream", with(esBulk, func(o *loggingv1.OutputSpec) { o.Elasticsearch.Version = 6 }), true,
+					"Invalid", "bulkMode data_stream requires Elasticsearch version 7 or later"),
+				Entry("Elasticsearch with a data stream spec without the data_stream bulk mode", with(esBulk, func(o *loggingv1.OutputSpec) {
+					o.Elasticsearch.BulkMode = loggingv1.ElasticsearchBulkModeIndex
+				}), true, "Invalid", "dataStream requires bulkMode data_stream"),
+				Entry("Elasticsearch bulk mode options if the collector is not vector", esBulk, false,
+					"Invalid", "bulkMode, dataStream, pipeline and idField are only supported by the vector collector"),
+
+				Entry("Elasticsearch aws auth with AWS keys in the secret", esAuth, true, "", ""),
+				Entry("Elasticsearch aws auth without a region", with(esAuth, func(o *loggingv1.OutputSpec) { o.Elasticsearch.Auth.Region = "" }), true,
+					"Invalid", "auth type aws requires region"),
+				Entry("Elasticsearch aws auth without AWS keys in the secret", with(esAuth, func(o *loggingv1.OutputSpec) { o.Secret.Name = "es-secret" }), true,
+					"MissingResource", "auth keys: aws_access_key_id and aws_secret_access_key are required"),
+				Entry("Elasticsearch apiKey auth with an api_key in the secret", with(esAuth, func(o *loggingv1.OutputSpec) {
+					o.Elasticsearch.Auth = &loggingv1.ElasticsearchAuth{Type: loggingv1.ElasticsearchAuthTypeAPIKey}
+					o.Secret.Name = "es-secret"
+				}), true, "", ""),
+				Entry("Elasticsearch apiKey auth without an api_key in the secret", with(esAuth, func(o *loggingv1.OutputSpec) {
+					o.Elasticsearch.Auth = &loggingv1.ElasticsearchAuth{Type: loggingv1.ElasticsearchAuthTypeAPIKey}
+				}), true, "MissingResource", "auth keys: api_key is required"),
+				Entry("Elasticsearch auth without a secret", with(esAuth, func(o *loggingv1.OutputSpec) { o.Secret = nil }), true,
+					"MissingResource", "secret must be provided for elasticsearch output with auth"),
+				Entry("Elasticsearch auth if the collector is not vector", esAuth, false, "Invalid", "auth is only supported by the vector collector"),
+
+				Entry("Loki with bounded label keys and pod label globs", loki, true, "", ""),
+				Entry("Loki with label keys that have the same label name", with(loki, func(o *loggingv1.OutputSpec) {
+					o.Loki.LabelKeys = []string{"kubernetes.labels.app-name", "kubernetes.labels.app.name"}
+				}), true, "Invalid", `label keys "kubernetes.labels.app-name" and "kubernetes.labels.app.name" have the same label name "kubernetes_labels_app_name"`),
+				Entry("Loki with a label key of a pod label also selected by a pod label glob", with(loki, func(o *loggingv1.OutputSpec) {
+					o.Loki.LabelKeys = []string{"kubernetes.labels.app.kubernetes.io/name"}
+				}), true, "Invalid", `label key "kubernetes.labels.app.kubernetes.io/name" is also selected by pod label key glob "app.kubernetes.io/\*"`),
+				Entry("Loki with a pod label glob that selects an unbounded pod label", with(loki, func(o *loggingv1.OutputSpec) { o.Loki.PodLabelKeys = []string{"*"} }), true,
+					"Invalid", `pod label key glob "\*" selects "pod-template-hash" which has unbounded values`),
+				Entry("Loki with unbounded keys and allowUnboundedLabels", with(loki, func(o *loggingv1.OutputSpec) {
+					o.Loki.LabelKeys = []string{"kubernetes.pod_name"}
+					o.Loki.PodLabelKeys = []string{"*"}
+					o.Loki.AllowUnboundedLabels = true
+				}), true, "", ""),
+				Entry("Loki with an unbounded label key", with(loki, func(o *loggingv1.OutputSpec) { o.Loki.LabelKeys = []string{"kubernetes.pod_name"} }), true, "", ""),
+				Entry("Loki with an invalid pod label glob", with(loki, func(o *loggingv1.OutputSpec) { o.Loki.PodLabelKeys = []string{"app name"} }), true,
+					"Invalid", `invalid pod label key glob "app name"`),
+				Entry("Loki label options if the collector is not vector", loki, false,
+					"Invalid", "podLabelKeys and maxLabelValues are only supported by the vector collector"),
+
+				Entry("Syslog with structured data and a max message size for udp", syslog, true, "", ""),
+				Entry("Syslog with structured data and rfc3164", with(syslog, func(o *loggingv1.OutputSpec) { o.Syslog.RFC = "RFC3164" }), true,
+					"Invalid", "structuredData requires rfc RFC5424"),
+				Entry("Syslog with an invalid param name", with(syslog, func(o *loggingv1.OutputSpec) { o.Syslog.StructuredData.Params = map[string]string{"name space": "x"} }), true,
+					"Invalid", `invalid structuredData param name "name space"`),
+				Entry("Syslog with framing for udp", with(syslog, func(o *loggingv1.OutputSpec) { o.Syslog.Framing = loggingv1.SyslogFramingNewline }), true,
+					"Invalid", "framing is only supported for tcp and tls"),
+				Entry("Syslog with octet counting framing", with(syslog, func(o *loggingv1.OutputSpec) {
+					o.URL = "tcp://syslog.example.com:514"
+					o.Syslog.MaxMessageSize = 0
+					o.Syslog.Framing = loggingv1.SyslogFramingOctetCounting
+				}), true, "", ""),
+				Entry("Syslog with octet counting framing and rfc3164", with(syslog, func(o *loggingv1.OutputSpec) {
+					o.URL = "tcp://syslog.example.com:514"
+					o.Syslog.MaxMessageSize = 0
+					o.Syslog.StructuredData = nil
+					o.Syslog.RFC = "RFC3164"
+					o.Syslog.Framing = loggingv1.SyslogFramingOctetCounting
+				}), true, "Invalid", "framing octetCounting requires rfc RFC5424"),
+				Entry("Syslog with an unsupported framing", with(syslog, func(o *loggingv1.OutputSpec) {
+					o.URL = "tcp://syslog.example.com:514"
+					o.Syslog.MaxMessageSize = 0
+					o.Syslog.Framing = "lengthPrefixed"
+				}), true, "Invalid", `unsupported framing "lengthPrefixed", must be one of newline, octetCounting`),
+				Entry("Syslog with structured data and addLogSource", with(syslog, func(o *loggingv1.OutputSpec) { o.Syslog.AddLogSource = true }), true,
+					"Invalid", "addLogSource can not be used with structuredData or framing octetCounting"),
+				Entry("Syslog with maxMessageSize for tcp", with(syslog, func(o *loggingv1.OutputSpec) { o.URL = "tcp://syslog.example.com:514" }), true,
+					"Invalid", "maxMessageSize is only supported for udp"),
+				Entry("Syslog options if the collector is not vector", syslog, false, "Invalid", "only supported by the vector collector"),
+
+				Entry("Http with format text and a textField", http, true, "", ""),
+				Entry("Http with a textField without format text", with(http, func(o *loggingv1.OutputSpec) { o.Http.Format = loggingv1.HttpFormatNDJSON }), true,
+					"Invalid", "textField requires format text"),
+				Entry("Http with an invalid textField", with(http, func(o *loggingv1.OutputSpec) { o.Http.TextField = "structured line" }), true,
+					"Invalid", "invalid textField"),
+				Entry("Http format if the collector is not vector", http, false,
+					"Invalid", "format and textField are only supported by the vector collector"),
+
+				Entry("service account token for an elasticsearch output", esToken, true, "", ""),
+				Entry("service account token for other output types", with(esToken, func(o *loggingv1.OutputSpec) {
+					o.Type = loggingv1.OutputTypeSyslog
+					o.URL = "tls://syslog.example.com:6514"
+				}), true, "Invalid", "serviceAccountToken is only supported by elasticsearch, http, loki outputs"),
+				Entry("service account token together with elasticsearch auth", with(esToken, func(o *loggingv1.OutputSpec) {
+					o.Elasticsearch = &loggingv1.Elasticsearch{Auth: &loggingv1.ElasticsearchAuth{Type: loggingv1.ElasticsearchAuthTypeAPIKey}}
+				}), true, "Invalid", "serviceAccountToken and auth cannot be used together"),
+				Entry("service account token with an expiration shorter than 10 minutes", with(esToken, func(o *loggingv1.OutputSpec) {
+					o.ServiceAccountToken.ExpirationSeconds = utils.GetPtr[int64](60)
+				}), true, "Invalid", "expirationSeconds must be at least 600"),
+				Entry("service account token if the collector is not vector", esToken, false,
+					"Invalid", "serviceAccountToken is only supported by the vector collector"),
+
+				Entry("LokiStack with a name and a token", lokiStack, true, "", ""),
+				Entry("LokiStack without a name", with(lokiStack, func(o *loggingv1.OutputSpec) { o.LokiStack.Name = "" }), true,
+					"Invalid", "LokiStack output requires name"),
+				Entry("LokiStack without a token in the secret", with(lokiStack, func(o *loggingv1.OutputSpec) { o.Secret.Name = secretName }), true,
+					"MissingResource", "auth keys: token is required"),
+				Entry("LokiStack without a secret", with(lokiStack, func(o *loggingv1.OutputSpec) { o.Secret = nil }), true, "", ""),
+				Entry("LokiStack if the collector is not vector", lokiStack, false, "Invalid", `output type "lokiStack" is only supported by the vector collector`),
+			)
+
+			It("should warn about Loki label keys with unbounded values", func() {
+				output := with(loki, func(o *loggingv1.OutputSpec) { o.Loki.LabelKeys = []string{"kubernetes.pod_name"} })
+				forwarderSpec.Pipelines = []loggingv1.PipelineSpec{{OutputRefs: []string{output.Name}}}
+				forwarderSpec.Outputs = []loggingv1.OutputSpec{output}
+				extras[constants.VectorName] = true
+				verifyOutputs(namespace, client, forwarderSpec, clfStatus, extras)
+				Expect(clfStatus.Outputs[output.Name]).To(HaveCondition("Ready", true, loggingv1.ReasonUnboundedLabels,
+					`label key "kubernetes.pod_name" has unbounded values`))
+			})
+		})
+
 		It("should fail outputs that have an invalid or non-absolute URL", func() {
 			forwarderSpec.Outputs = []loggingv1.OutputSpec{
 				{
@@ -427,6 +728,41 @@ var _ = Describe("Validate clusterlogforwarderspec", func() {
 					stsMessage := "auth keys: a 'role_arn' or 'credentials' key is required containing a valid arn value"
 					Expect(clfStatus.Outputs["aName"]).To(HaveCondition("Ready", false, "MissingResource", stsMessage))
 				})
+				Context("with several AWS outputs", func() {
+					var otherSecret *corev1.Secret
+					BeforeEach(func() {
+						secret.Data[constants.AWSWebIdentityRoleKey] = []byte("arn:aws:iam::123456789012:role/my-role")
+						otherSecret = secret.DeepCopy()
+						otherSecret.Name = "other-secret"
+						other := output
+						other.Name = "other"
+						other.Secret = &loggingv1.OutputSecretSpec{Name: otherSecret.Name}
+						forwarderSpec.Outputs = append(forwarderSpec.Outputs, other)
+						forwarderSpec.Pipelines[0].OutputRefs = append(forwarderSpec.Pipelines[0].OutputRefs, other.Name)
+					})
+					It("should pass outputs that use the same role", func() {
+						client = fake.NewFakeClient(secret, otherSecret) //nolint
+						verifyOutputs(namespace, client, forwarderSpec, clfStatus, extras)
+						Expect(clfStatus.Outputs["aName"]).To(HaveCondition("Ready", true, "", ""))
+						Expect(clfStatus.Outputs["other"]).To(HaveCondition("Ready", true, "", ""))
+					})
+					It("should fail outputs that use a different role than a previous output", func() {
+						otherSecret.Data[constants.AWSWebIdentityRoleKey] = []byte("arn:aws:iam::123456789012:role/other-role")
+						client = fake.NewFakeClient(secret, otherSecret) //nolint
+						verifyOutputs(namespace, client, forwarderSpec, clfStatus, extras)
+						Expect(clfStatus.Outputs["aName"]).To(HaveCondition("Ready", true, "", ""))
+						Expect(clfStatus.Outputs["other"]).To(HaveCondition("Ready", false, loggingv1.ReasonInvalid, `role "arn:aws:iam::123456789012:role/other-role" conflicts with role "arn:aws:iam::123456789012:role/my-role" of another output`))
+					})
+					It("should pass outputs that use static keys with an output that uses a role", func() {
+						otherSecret.Data = map[string][]byte{
+							constants.AWSAccessKeyID:     {0, 1, 2},
+							constants.AWSSecretAccessKey: {0, 1, 2},
+						}
+						client = fake.NewFakeClient(secret, otherSecret) //nolint
+						verifyOutputs(namespace, client, forwarderSpec, clfStatus, extras)
+						Expect(clfStatus.Outputs["other"]).To(HaveCondition("Ready", true, "", ""))
+					})
+				})
 			})
 
 			Context("for writing to Splunk", func() {
@@ -537,6 +873,83 @@ var _ = Describe("Validate clusterlogforwarderspec", func() {
 					Expect(clfStatus.Outputs["aName"]).To(HaveCondition("Ready", true, "", ""))
 				})
 			})
+
+			Context("with TLS references", func() {
+				var (
+					caConfigMap *corev1.ConfigMap
+					certSecret  *corev1.Secret
+				)
+				BeforeEach(func() {
+					caConfigMap = runtime.NewConfigMap(constants.OpenshiftNS, "pki-ca", map[string]string{
+						"ca-bundle.crt": "ca",
+					})
+					certSecret = runtime.NewSecret(constants.OpenshiftNS, "client-cert", map[string][]byte{
+						"tls.crt":    {0, 1, 2},
+						"tls.key":    {0, 1, 2},
+						"passphrase": {0, 1, 2},
+					})
+					client = fake.NewFakeClient(caConfigMap, certSecret) //nolint
+					output = loggingv1.OutputSpec{
+						Name: "aName",
+						Type: "http",
+						URL:  "https://somewhere",
+						TLS: &loggingv1.OutputTLSSpec{
+							CA:            &loggingv1.ValueReference{Key: "ca-bundle.crt", ConfigMapName: "pki-ca"},
+							Certificate:   &loggingv1.ValueReference{Key: "tls.crt", SecretName: "client-cert"},
+							Key:           &loggingv1.SecretReference{Key: "tls.key", SecretName: "client-cert"},
+							KeyPassphrase: &loggingv1.SecretReference{Key: "passphrase", SecretName: "client-cert"},
+						},
+					}
+					forwarderSpec.Pipelines = []loggingv1.PipelineSpec{{OutputRefs: []string{output.Name}}}
+					forwarderSpec.Outputs = []loggingv1.OutputSpec{output}
+					extras[constants.VectorName] = true
+				})
+				It("should pass outputs without a secret when the references exist", func() {
+					verifyOutputs(namespace, client, forwarderSpec, clfStatus, extras)
+					Expect(clfStatus.Outputs["aName"]).To(HaveCondition("Ready", true, "", ""))
+				})
+				It("should fail outputs with a certificate without a key", func() {
+					forwarderSpec.Outputs[0].TLS.Key = nil
+					verifyOutputs(namespace, client, forwarderSpec, clfStatus, extras)
+					Expect(clfStatus.Outputs["aName"]).To(HaveCondition("Ready", false, "MissingResource", "cannot have tls.certificate without tls.key"))
+				})
+				It("should fail outputs with a passphrase without a key", func() {
+					forwarderSpec.Outputs[0].TLS.Certificate = nil
+					forwarderSpec.Outputs[0].TLS.Key = nil
+					verifyOutputs(namespace, client, forwarderSpec, clfStatus, extras)
+					Expect(clfStatus.Outputs["aName"]).To(HaveCondition("Ready", false, "MissingResource", "cannot have tls.keyPassphrase without tls.key"))
+				})
+				It("should fail outputs with a reference to both a configmap and a secret", func() {
+					forwarderSpec.Outputs[0].TLS.CA.SecretName = "client-cert"
+					verifyOutputs(namespace, client, forwarderSpec, clfStatus, extras)
+					Expect(clfStatus.Outputs["aName"]).To(HaveCondition("Ready", false, "Invalid", "tls.ca: exactly one of configMapName or secretName is required"))
+				})
+				It("should fail outputs with a missing configmap", func() {
+					forwarderSpec.Outputs[0].TLS.CA.ConfigMapName = "other-ca"
+					verifyOutputs(namespace, client, forwarderSpec, clfStatus, extras)
+					Expect(clfStatus.Outputs["aName"]).To(HaveCondition("Ready", false, "MissingResource", `tls.ca: configmap "other-ca" not found`))
+				})
+				It("should fail outputs with a missing configmap key", func() {
+					forwarderSpec.Outputs[0].TLS.CA.Key = "service-ca.crt"
+					verifyOutputs(namespace, client, forwarderSpec, clfStatus, extras)
+					Expect(clfStatus.Outputs["aName"]).To(HaveCondition("Ready", false, "MissingResource", `tls.ca: key "service-ca.crt" not found in configmap "pki-ca"`))
+				})
+				It("should fail outputs with a missing secret key", func() {
+					forwarderSpec.Outputs[0].TLS.Key.Key = "tls.pem"
+					verifyOutputs(namespace, client, forwarderSpec, clfStatus, extras)
+					Expect(clfStatus.Outputs["aName"]).To(HaveCondition("Ready", false, "MissingResource", `tls.key: key "tls.pem" not found in secret "client-cert"`))
+				})
+				It("should fail outputs with a missing secret", func() {
+					forwarderSpec.Outputs[0].TLS.KeyPassphrase.SecretName = "other-secret"
+					verifyOutputs(namespace, client, forwarderSpec, clfStatus, extras)
+					Expect(clfStatus.Outputs["aName"]).To(HaveCondition("Ready", false, "MissingResource", `tls.keyPassphrase: secret "other-secret" not found`))
+				})
+				It("should fail if the collector is not vector", func() {
+					extras[constants.VectorName] = false
+					verifyOutputs(namespace, client, forwarderSpec, clfStatus, extras)
+					Expect(clfStatus.Outputs["aName"]).To(HaveCondition("Ready", false, "Invalid", "TLS references are only supported by the vector collector"))
+				})
+			})
 		})
 
 		It("should pass well formed outputs", func() {
@@ -663,6 +1076,49 @@ var _ = Describe("Validate clusterlogforwarderspec", func() {
 				Expect(forwarderSpec.Outputs).To(HaveLen(len(forwarderSpec.Outputs)))
 				Expect(clfStatus.Outputs[splunkOutputName]).To(HaveCondition("Ready", false, loggingv1.ReasonInvalid, "output \""+splunkOutputName+"\": Only one of indexKey or indexName can be set, not both."))
 			})
+
+			Context("with metadata templates and acknowledgements", func() {
+				BeforeEach(func() {
+					forwarderSpec.Outputs = []loggingv1.OutputSpec{
+						{
+							Name: splunkOutputName,
+							Type: loggingv1.OutputTypeSplunk,
+							URL:  "https://splunk-web:8088/endpoint",
+							OutputTypeSpec: loggingv1.OutputTypeSpec{
+								Splunk: &loggingv1.Splunk{
+									Source:     `{.kubernetes.namespace_name||"none"}:{.kubernetes.container_name||"none"}`,
+									SourceType: `openshift:{.log_type||"unknown"}`,
+									Host:       `{.hostname||"unknown"}`,
+									Acknowledgements: &loggingv1.SplunkAcknowledgements{
+										Enabled:       true,
+										QueryInterval: 5,
+									},
+								},
+							},
+							Secret: &loggingv1.OutputSecretSpec{Name: splunkSecret.Name},
+						},
+					}
+				})
+				It("should pass with valid templates and acknowledgements", func() {
+					verifyOutputs(namespace, client, &forwarderSpec, clfStatus, extras)
+					Expect(clfStatus.Outputs[splunkOutputName]).To(HaveCondition("Ready", true, "", ""))
+				})
+				It("should fail a sourceType template without fallback", func() {
+					forwarderSpec.Outputs[0].Splunk.SourceType = "openshift:{.log_type}"
+					verifyOutputs(namespace, client, &forwarderSpec, clfStatus, extras)
+					Expect(clfStatus.Outputs[splunkOutputName]).To(HaveCondition("Ready", false, loggingv1.ReasonInvalid, `invalid sourceType: .* requires a fallback value for field ".log_type"`))
+				})
+				It("should fail a host template with an unknown field", func() {
+					forwarderSpec.Outputs[0].Splunk.Host = `{.node||"unknown"}`
+					verifyOutputs(namespace, client, &forwarderSpec, clfStatus, extras)
+					Expect(clfStatus.Outputs[splunkOutputName]).To(HaveCondition("Ready", false, loggingv1.ReasonInvalid, `invalid host: .* references unknown field ".node"`))
+				})
+				It("should fail acknowledgement settings when acknowledgements are disabled", func() {
+					forwarderSpec.Outputs[0].Splunk.Acknowledgements.Enabled = false
+					verifyOutputs(namespace, client, &forwarderSpec, clfStatus, extras)
+					Expect(clfStatus.Outputs[splunkOutputName]).To(HaveCondition("Ready", false, loggingv1.ReasonInvalid, "acknowledgements queryInterval and retryLimit require acknowledgements to be enabled"))
+				})
+			})
 		})
 	})
 
@@ -1052,6 +1508,67 @@ var _ = Describe("Validate clusterlogforwarderspec", func() {
 			Expect(clfStatus.Conditions).To(HaveCondition(loggingv1.ConditionReady, false, loggingv1.ReasonInvalid, "invalid clf spec; one or more errors present: *"))
 		})
 
+		Context("when invalid pipelines are dropped", func() {
+			BeforeEach(func() {
+				forwarderSpec.InvalidPipelinePolicy = loggingv1.InvalidPipelinePolicyDrop
+				forwarderSpec.Inputs = []loggingv1.InputSpec{
+					{Name: "app-logs", Application: &loggingv1.Application{}},
+					{Name: "inval-input"},
+				}
+				forwarderSpec.Pipelines = append(forwarderSpec.Pipelines, loggingv1.PipelineSpec{
+					Name:       "inval-pipeline",
+					OutputRefs: []string{output.Name},
+					InputRefs:  []string{"inval-input"},
+				})
+			})
+
+			It("should be ready and degraded if at least one pipeline is valid", func() {
+				err, clfStatus := ValidateInputsOutputsPipelines(*clfInstance, client, extras)
+				Expect(err).To(BeNil())
+				Expect(clfStatus.Pipelines["valid-pipeline"]).To(HaveCondition(loggingv1.ConditionReady, true, "", ""))
+				Expect(clfStatus.Pipelines["inval-pipeline"]).To(HaveCondition(loggingv1.ValidationCondition, true, loggingv1.ValidationFailureReason, `invalid: unrecognized inputs: \[inval-input\]`))
+				Expect(clfStatus.Conditions).To(HaveCondition(loggingv1.ConditionReady, true, "", ""))
+				Expect(clfStatus.Conditions).To(HaveCondition(loggingv1.ConditionDegraded, true, loggingv1.ReasonInvalid, `invalid pipelines are not forwarded: \[inval-pipeline\]`))
+			})
+
+			It("should be invalid if no pipeline is valid", func() {
+				forwarderSpec.Pipelines = forwarderSpec.Pipelines[1:]
+				err, clfStatus := ValidateInputsOutputsPipelines(*clfInstance, client, extras)
+				Expect(err).ToNot(BeNil())
+				Expect(clfStatus.Conditions).To(HaveCondition(loggingv1.ConditionReady, false, loggingv1.ReasonInvalid, "invalid clf spec; one or more errors present: *"))
+			})
+
+			It("should restrict the spec to the ready pipelines and their references", func() {
+				_, clfStatus := ValidateInputsOutputsPipelines(*clfInstance, client, extras)
+				spec := ReadyPipelines(*forwarderSpec, *clfStatus)
+				Expect(spec.Pipelines).To(HaveLen(1))
+				Expect(spec.Pipelines[0].Name).To(Equal("valid-pipeline"))
+				Expect(spec.Inputs).To(BeEmpty())
+				Expect(spec.Outputs).To(Equal([]loggingv1.OutputSpec{output, otherOutput}))
+				Expect(forwarderSpec.Pipelines).To(HaveLen(2), "Exp. not to mutate original spec pipelines")
+			})
+
+			It("should drop only the pipelines that reference an invalid output", func() {
+				forwarderSpec.Outputs = append(forwarderSpec.Outputs, loggingv1.OutputSpec{
+					Name: "insecure-out",
+					Type: loggingv1.OutputTypeHttp,
+					URL:  "http://local.svc:8080",
+					TLS:  &loggingv1.OutputTLSSpec{InsecureSkipVerify: true},
+				})
+				forwarderSpec.Pipelines[1] = loggingv1.PipelineSpec{
+					Name:       "inval-pipeline",
+					OutputRefs: []string{"insecure-out"},
+					InputRefs:  []string{"app-logs"},
+				}
+				err, clfStatus := ValidateInputsOutputsPipelines(*clfInstance, client, extras)
+				Expect(err).To(BeNil())
+				Expect(clfStatus.Outputs["insecure-out"]).To(HaveCondition(loggingv1.ConditionReady, false, loggingv1.ReasonInvalid, "provided not secure URL along with TLS configuration"))
+				Expect(clfStatus.Pipelines["valid-pipeline"]).To(HaveCondition(loggingv1.ConditionReady, true, "", ""))
+				Expect(clfStatus.Conditions).To(HaveCondition(loggingv1.ConditionDegraded, true, loggingv1.ReasonInvalid, `invalid pipelines are not forwarded: \[inval-pipeline\]`))
+				Expect(ReadyPipelines(*forwarderSpec, *clfStatus).Outputs).ToNot(ContainElement(HaveField("Name", "insecure-out")))
+			})
+		})
+
 		It("should have no status if spec has empty pipelines and no forwarder instance", func() {
 			forwarderSpec = &loggingv1.ClusterLogForwarderSpec{
 				Inputs:    []loggingv1.InputSpec{},
@@ -1159,6 +1676,48 @@ var _ = Describe("Validate clusterlogforwarderspec", func() {
 			Expect(clfStatus.Pipelines[pipelineName]).To(HaveCondition(loggingv1.ValidationCondition, true, loggingv1.ValidationFailureReason, "invalid: unrecognized filters*"))
 		})
 
+		It("should fail with an invalid filter in pipeline", func() {
+			forwarderSpec := &loggingv1.ClusterLogForwarderSpec{
+				Filters: []loggingv1.FilterSpec{
+					{
+						Name: filterName,
+						Type: loggingv1.FilterDrop,
+						FilterTypeSpec: loggingv1.FilterTypeSpec{
+							Drop: []loggingv1.DropTest{
+								{DropConditions: []loggingv1.DropCondition{{Field: "no-leading-dot", Matches: "foo"}}},
+							},
+						},
+					},
+				},
+				Outputs: []loggingv1.OutputSpec{
+					{
+						Name: outputName,
+						Type: loggingv1.OutputTypeElasticsearch,
+						URL:  esURL,
+						OutputTypeSpec: loggingv1.OutputTypeSpec{
+							Elasticsearch: &loggingv1.Elasticsearch{},
+						},
+					},
+				},
+				Pipelines: []loggingv1.PipelineSpec{
+					{
+						FilterRefs: []string{filterName},
+						InputRefs:  []string{loggingv1.InputNameApplication},
+						OutputRefs: []string{outputName},
+						Name:       pipelineName,
+					},
+				},
+			}
+			clf := loggingv1.ClusterLogForwarder{}
+			clf.Spec = *forwarderSpec
+			clf.Name = constants.SingletonName
+			clf.Namespace = constants.OpenshiftNS
+
+			_, clfStatus = ValidateInputsOutputsPipelines(clf, client, extras)
+			Expect(clfStatus.Filters[filterName]).To(HaveCondition(loggingv1.ConditionReady, false, loggingv1.ReasonInvalid, "invalid field path*"))
+			Expect(clfStatus.Pipelines[pipelineName]).To(HaveCondition(loggingv1.ValidationCondition, true, loggingv1.ValidationFailureReason, "invalid: unrecognized filters*"))
+		})
+
 	})
 })
 
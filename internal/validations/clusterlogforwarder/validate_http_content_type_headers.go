@@ -3,33 +3,60 @@ package clusterlogforwarder
 import (
 	log "github.com/ViaQ/logerr/v2/log/static"
 	"github.com/openshift/cluster-logging-operator/apis/logging/v1"
-	"github.com/openshift/cluster-logging-operator/internal/validations/errors"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/http"
+	"github.com/openshift/cluster-logging-operator/internal/status"
+	"github.com/openshift/cluster-logging-operator/internal/validations/clusterlogforwarder/conditions"
 	"reflect"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 	"strings"
 )
 
 var validContentTypes = map[string]string{
-	"application/json":     "json",
-	"application/x-ndjson": "ndjson",
+	"application/json":     v1.HttpFormatJSON,
+	"application/x-ndjson": v1.HttpFormatNDJSON,
+	"text/plain":           v1.HttpFormatText,
 }
 
-// validateHttpContentTypeHeaders will validate Content-Type header in Http Output
-// valid content-type are: "application/json" and "application/x-ndjson"
+// verifyHttpContentTypeHeaders will validate Content-Type header in Http Output
+// valid content-type are: "application/json", "application/x-ndjson" and, for vector, "text/plain" with format text.
+// The content-type must match the format of the output when spec'd
 // was introduced in https://github.com/openshift/cluster-logging-operator/pull/1924
 // for https://issues.redhat.com/browse/LOG-3784
-func validateHttpContentTypeHeaders(clf v1.ClusterLogForwarder, k8sClient client.Client, extras map[string]bool) (error, *v1.ClusterLogForwarderStatus) {
-	for i, output := range clf.Spec.Outputs {
-		_, output := i, output // Don't bind range variable.
-		if output.Type == v1.OutputTypeHttp && output.Http != nil {
-			if contentType, found := output.Http.Headers["Content-Type"]; found && validContentTypes[strings.ToLower(contentType)] == "" {
-				validKeys := reflect.ValueOf(validContentTypes).MapKeys()
-				log.V(3).Info("validateHttpContentTypeHeaders failed", "reason", "not valid content type set in headers",
-					"content type", contentType, "supported types: ", validKeys)
-				return errors.NewValidationError("not valid content type set in headers: %s , supported types: %s",
-					contentType, validKeys), nil
-			}
-		}
+func verifyHttpContentTypeHeaders(output *v1.OutputSpec, conds v1.NamedConditions, extras map[string]bool) bool {
+	if output.Http == nil {
+		return true
 	}
-	return nil, nil
+	contentType, found := http.ContentType(output.Http)
+	if !found {
+		return true
+	}
+	fail := func(c status.Condition) bool {
+		conds.Set(output.Name, c)
+		return false
+	}
+	format := validContentTypes[strings.ToLower(contentType)]
+	if format == "" {
+		validKeys := reflect.ValueOf(validContentTypes).MapKeys()
+		log.V(3).Info("verifyHttpContentTypeHeaders failed", "reason", "not valid content type set in headers",
+			"content type", contentType, "supported types: ", validKeys)
+		return fail(conditions.CondInvalid("output %q: not valid content type set in headers: %s , supported types: %s",
+			output.Name, contentType, validKeys))
+	}
+	if output.Http.Format != "" && output.Http.Format != format {
+		log.V(3).Info("verifyHttpContentTypeHeaders failed", "reason", "content type set in headers does not match format",
+			"content type", contentType, "format", output.Http.Format)
+		return fail(conditions.CondInvalid("output %q: content type set in headers: %s does not match format %s, expected: %s",
+			output.Name, contentType, output.Http.Format, http.FormatContentTypes[output.Http.Format]))
+	}
+	if format == v1.HttpFormatText && !extras[constants.VectorName] {
+		log.V(3).Info("verifyHttpContentTypeHeaders failed", "reason", "content type is only supported by vector", "content type", contentType)
+		return fail(conditions.CondInvalid("output %q: content type set in headers: %s is only supported by the vector collector",
+			output.Name, contentType))
+	}
+	if format == v1.HttpFormatText && output.Http.Format == "" {
+		log.V(3).Info("verifyHttpContentTypeHeaders failed", "reason", "content type requires format", "content type", contentType)
+		return fail(conditions.CondInvalid("output %q: content type set in headers: %s requires format %s",
+			output.Name, contentType, v1.HttpFormatText))
+	}
+	return true
 }
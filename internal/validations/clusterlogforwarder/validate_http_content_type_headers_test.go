@@ -4,6 +4,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
 )
 
 var _ = Describe("[internal][validations] ClusterLogForwarder will validate Content-Type header in Http Output", func() {
@@ -28,34 +29,67 @@ var _ = Describe("[internal][validations] ClusterLogForwarder will validate Cont
 		}
 	})
 
-	Context("#validateHttpContentTypeHeaders", func() {
+	Context("#verifyHttpContentTypeHeaders", func() {
 
 		It("should pass validation with empty headers", func() {
-			Expect(validateHttpContentTypeHeaders(*clf, nil, nil)).To(Succeed())
+			Expect(verifyHttpContentTypeHeaders(&clf.Spec.Outputs[0], v1.NamedConditions{}, nil)).To(BeTrue())
 		})
 		It("should pass validation when not Content Type header", func() {
 			clf.Spec.Outputs[0].Http.Headers = map[string]string{
 				"Accept": "application/json",
 			}
-			Expect(validateHttpContentTypeHeaders(*clf, nil, nil)).To(Succeed())
+			Expect(verifyHttpContentTypeHeaders(&clf.Spec.Outputs[0], v1.NamedConditions{}, nil)).To(BeTrue())
 		})
 		It("should pass validation when the Content Type header is application/json", func() {
 			clf.Spec.Outputs[0].Http.Headers = map[string]string{
 				"Content-Type": "application/json",
 			}
-			Expect(validateHttpContentTypeHeaders(*clf, nil, nil)).To(Succeed())
+			Expect(verifyHttpContentTypeHeaders(&clf.Spec.Outputs[0], v1.NamedConditions{}, nil)).To(BeTrue())
 		})
 		It("should pass validation when the Content Type header is application/x-ndjson", func() {
 			clf.Spec.Outputs[0].Http.Headers = map[string]string{
 				"Content-Type": "application/x-ndjson",
 			}
-			Expect(validateHttpContentTypeHeaders(*clf, nil, nil)).To(Succeed())
+			Expect(verifyHttpContentTypeHeaders(&clf.Spec.Outputs[0], v1.NamedConditions{}, nil)).To(BeTrue())
 		})
 		It("should fail validation when not valid content types", func() {
 			clf.Spec.Outputs[0].Http.Headers = map[string]string{
 				"Content-Type": "application/x-www-form-urlencoded",
 			}
-			Expect(validateHttpContentTypeHeaders(*clf, nil, nil)).To(Not(Succeed()))
+			Expect(verifyHttpContentTypeHeaders(&clf.Spec.Outputs[0], v1.NamedConditions{}, nil)).To(BeFalse())
+		})
+		It("should pass validation when the Content Type header matches the format", func() {
+			clf.Spec.Outputs[0].Http.Format = v1.HttpFormatNDJSON
+			clf.Spec.Outputs[0].Http.Headers = map[string]string{
+				"content-type": "application/x-ndjson",
+			}
+			Expect(verifyHttpContentTypeHeaders(&clf.Spec.Outputs[0], v1.NamedConditions{}, nil)).To(BeTrue())
+		})
+		It("should fail validation when the Content Type header does not match the format", func() {
+			clf.Spec.Outputs[0].Http.Format = v1.HttpFormatText
+			clf.Spec.Outputs[0].Http.Headers = map[string]string{
+				"Content-Type": "application/json",
+			}
+			Expect(verifyHttpContentTypeHeaders(&clf.Spec.Outputs[0], v1.NamedConditions{}, map[string]bool{constants.VectorName: true})).To(BeFalse())
+		})
+		It("should pass validation when the Content Type header is text/plain with format text for vector", func() {
+			clf.Spec.Outputs[0].Http.Format = v1.HttpFormatText
+			clf.Spec.Outputs[0].Http.Headers = map[string]string{
+				"Content-Type": "text/plain",
+			}
+			Expect(verifyHttpContentTypeHeaders(&clf.Spec.Outputs[0], v1.NamedConditions{}, map[string]bool{constants.VectorName: true})).To(BeTrue())
+		})
+		It("should fail validation when the Content Type header is text/plain without a format", func() {
+			clf.Spec.Outputs[0].Http.Headers = map[string]string{
+				"Content-Type": "text/plain",
+			}
+			Expect(verifyHttpContentTypeHeaders(&clf.Spec.Outputs[0], v1.NamedConditions{}, map[string]bool{constants.VectorName: true})).To(BeFalse())
+		})
+		It("should fail validation when the Content Type header is text/plain for fluentd", func() {
+			clf.Spec.Outputs[0].Http.Headers = map[string]string{
+				"Content-Type": "text/plain",
+			}
+			Expect(verifyHttpContentTypeHeaders(&clf.Spec.Outputs[0], v1.NamedConditions{}, nil)).To(BeFalse())
 		})
 		It("should pass validation when not Http Output", func() {
 			notHttpClf := &v1.ClusterLogForwarder{
@@ -74,7 +108,7 @@ var _ = Describe("[internal][validations] ClusterLogForwarder will validate Cont
 			clf.Spec.Outputs[0].Http.Headers = map[string]string{
 				"Content-Type": "application/x-www-form-urlencoded",
 			}
-			Expect(validateHttpContentTypeHeaders(*notHttpClf, nil, nil)).To(Succeed())
+			Expect(verifyHttpContentTypeHeaders(&notHttpClf.Spec.Outputs[0], v1.NamedConditions{}, nil)).To(BeTrue())
 		})
 	})
 })
@@ -4,17 +4,15 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/cluster-logging-operator/apis/logging/v1"
-	corev1 "k8s.io/api/core/v1"
-	"sigs.k8s.io/controller-runtime/pkg/client"
+	"github.com/openshift/cluster-logging-operator/internal/validations/clusterlogforwarder/conditions"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
 )
 
 var _ = Describe("[internal][validations] ClusterLogForwarder", func() {
 	var (
-		clf       *v1.ClusterLogForwarder
-		pipeline  v1.PipelineSpec
-		es        *v1.Elasticsearch
-		extras    = map[string]bool{}
-		k8sClient client.Client
+		clf      *v1.ClusterLogForwarder
+		pipeline v1.PipelineSpec
+		es       *v1.Elasticsearch
 	)
 	BeforeEach(func() {
 		es = &v1.Elasticsearch{}
@@ -41,42 +39,51 @@ var _ = Describe("[internal][validations] ClusterLogForwarder", func() {
 		}
 	})
 
-	Context("#validateJsonParsingToElasticsearch", func() {
+	Context("#verifyJsonParsingToElasticsearch", func() {
 
 		It("should fail validation when the pipeline includes Elasticsearch and structuredTypeKey or structuredTypeName is missing", func() {
-			err, status := validateJsonParsingToElasticsearch(*clf, k8sClient, extras)
-			Expect(err).To(Not(BeNil()))
-			Expect(status).To(Not(BeNil()))
-			Expect(len(status.Conditions)).To(Equal(1))
-			Expect(status.Conditions[0].Status).To(Equal(corev1.ConditionFalse))
-			Expect(status.Conditions[0].Reason).To(Equal(v1.ReasonInvalid))
+			Expect(verifyJsonParsingToElasticsearch(&clf.Spec, pipeline)).To(MatchError(ContainSubstring("structuredTypeKey or structuredTypeName must be defined")))
+		})
+		It("should drop only the pipeline that enables JSON parsing when invalid pipelines are dropped", func() {
+			clf.Spec.InvalidPipelinePolicy = v1.InvalidPipelinePolicyDrop
+			clf.Spec.Pipelines[0].Name = "parsed"
+			clf.Spec.Pipelines = append(clf.Spec.Pipelines, v1.PipelineSpec{
+				Name:       "unparsed",
+				InputRefs:  []string{string(v1.InputNameApplication)},
+				OutputRefs: []string{"anOutput"},
+			})
+			status := &v1.ClusterLogForwarderStatus{Outputs: v1.NamedConditions{}}
+			status.Outputs.Set("anOutput", conditions.CondReady)
+			verifyPipelines(clf.Name, &clf.Spec, status)
+			Expect(status.Pipelines["parsed"]).To(HaveCondition(v1.ConditionReady, false, v1.ReasonInvalid, "structuredTypeKey or structuredTypeName must be defined"))
+			Expect(status.Pipelines["unparsed"]).To(HaveCondition(v1.ConditionReady, true, "", ""))
 		})
 		It("should pass validation when the pipeline includes Elasticsearch and structuredTypeName is spec'd", func() {
 			es.StructuredTypeName = "foo"
 			clf.Spec.Outputs[0].Elasticsearch = es
-			Expect(validateJsonParsingToElasticsearch(*clf, k8sClient, extras)).To(Succeed())
+			Expect(verifyJsonParsingToElasticsearch(&clf.Spec, clf.Spec.Pipelines[0])).To(Succeed())
 		})
 		It("should pass validation when the pipeline includes Elasticsearch and structuredTypeKey is spec'd", func() {
 			es.StructuredTypeKey = "foo"
 			clf.Spec.Outputs[0].Elasticsearch = es
-			Expect(validateJsonParsingToElasticsearch(*clf, k8sClient, extras)).To(Succeed())
+			Expect(verifyJsonParsingToElasticsearch(&clf.Spec, clf.Spec.Pipelines[0])).To(Succeed())
 		})
 		It("should pass validation when the pipeline includes Elasticsearch and OutputDefaults.StructuredTypeName is spec'd", func() {
 			clf.Spec.OutputDefaults = &v1.OutputDefaults{Elasticsearch: &v1.ElasticsearchStructuredSpec{StructuredTypeName: "foo"}}
-			Expect(validateJsonParsingToElasticsearch(*clf, k8sClient, extras)).To(Succeed())
+			Expect(verifyJsonParsingToElasticsearch(&clf.Spec, clf.Spec.Pipelines[0])).To(Succeed())
 		})
 		It("should pass validation when the pipeline includes Elasticsearch and and OutputDefaults.StructuredTypeKey is spec'd", func() {
 			clf.Spec.OutputDefaults = &v1.OutputDefaults{Elasticsearch: &v1.ElasticsearchStructuredSpec{StructuredTypeKey: "foo"}}
-			Expect(validateJsonParsingToElasticsearch(*clf, k8sClient, extras)).To(Succeed())
+			Expect(verifyJsonParsingToElasticsearch(&clf.Spec, clf.Spec.Pipelines[0])).To(Succeed())
 		})
 		It("should pass validation when the pipeline does not ref an Elasticsearch output type", func() {
 			clf.Spec.Outputs[0].Type = v1.OutputTypeCloudwatch
-			Expect(validateJsonParsingToElasticsearch(*clf, k8sClient, extras)).To(Succeed())
+			Expect(verifyJsonParsingToElasticsearch(&clf.Spec, clf.Spec.Pipelines[0])).To(Succeed())
 		})
 
 		It("should pass validation when the pipeline does not spec JSON parsing", func() {
 			clf.Spec.Pipelines[0].Parse = ""
-			Expect(validateJsonParsingToElasticsearch(*clf, k8sClient, extras)).To(Succeed())
+			Expect(verifyJsonParsingToElasticsearch(&clf.Spec, clf.Spec.Pipelines[0])).To(Succeed())
 		})
 
 	})
@@ -0,0 +1,134 @@
+package clusterlogforwarder
+
+import (
+	"context"
+	"regexp"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/utils/sets"
+	"github.com/openshift/cluster-logging-operator/internal/validations/clusterlogforwarder/conditions"
+	"github.com/openshift/cluster-logging-operator/internal/validations/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	concurrencyRegex = regexp.MustCompile(`^(adaptive|none|[1-9][0-9]*)$`)
+
+	httpCompression = sets.NewString("none", "gzip", "zlib", "zstd", "snappy")
+
+	// deliveryTuning is the delivery tuning supported by the collector sink of each output type
+	deliveryTuning = map[string]struct {
+		batch       bool
+		request     bool
+		compression *sets.String
+	}{
+		loggingv1.OutputTypeAzureMonitor:       {batch: true, request: true, compression: sets.NewString("none")},
+		loggingv1.OutputTypeCloudwatch:         {batch: true, request: true, compression: httpCompression},
+		loggingv1.OutputTypeElasticsearch:      {batch: true, request: true, compression: httpCompression},
+		loggingv1.OutputTypeGoogleCloudLogging: {batch: true, request: true, compression: sets.NewString("none")},
+		loggingv1.OutputTypeHttp:               {batch: true, request: true, compression: httpCompression},
+		loggingv1.OutputTypeKafka:              {batch: true, request: false, compression: sets.NewString("none", "gzip", "snappy", "lz4", "zstd")},
+		loggingv1.OutputTypeLoki:               {batch: true, request: true, compression: sets.NewString("none", "gzip", "snappy")},
+		loggingv1.OutputTypeLokiStack:          {batch: true, request: true, compression: sets.NewString("none", "gzip", "snappy")},
+		loggingv1.OutputTypeOTLP:               {batch: true, request: true, compression: sets.NewString("none", "gzip")},
+		loggingv1.OutputTypeS3:                 {batch: true, request: true, compression: sets.NewString("none", "gzip", "zstd")},
+		loggingv1.OutputTypeSplunk:             {batch: true, request: true, compression: httpCompression},
+	}
+)
+
+// verifyOutputTuning verifies the tuning parameters of an output are consistent and supported by the output type
+func verifyOutputTuning(output *loggingv1.OutputSpec, conds loggingv1.NamedConditions, extras map[string]bool) bool {
+	fail := func(format string, args ...interface{}) bool {
+		conds.Set(output.Name, conditions.CondInvalid("output %q: "+format, append([]interface{}{output.Name}, args...)...))
+		return false
+	}
+	tuning := output.Tuning
+	if tuning == nil {
+		return true
+	}
+	if !extras[constants.VectorName] {
+		return fail("tuning is only supported by the vector collector")
+	}
+	supported := deliveryTuning[output.Type]
+	if tuning.HasBatch() && !supported.batch {
+		return fail("%s output does not support batch tuning", output.Type)
+	}
+	if tuning.MaxBatchBytes != nil && output.Type == loggingv1.OutputTypeOTLP {
+		return fail("%s output does not support maxBatchBytes tuning", output.Type)
+	}
+	if tuning.HasRequest() && !supported.request {
+		return fail("%s output does not support retry or concurrency tuning", output.Type)
+	}
+	if tuning.Compression != "" && (supported.compression == nil || !supported.compression.Has(tuning.Compression)) {
+		return fail("%s output does not support compression %q", output.Type, tuning.Compression)
+	}
+	if tuning.Concurrency != "" && !concurrencyRegex.MatchString(tuning.Concurrency) {
+		return fail("concurrency must be adaptive, none or a positive number")
+	}
+	if tuning.MinRetryDurationSecs != nil && tuning.MaxRetryDurationSecs != nil && *tuning.MinRetryDurationSecs > *tuning.MaxRetryDurationSecs {
+		return fail("minRetryDurationSecs must not be greater than maxRetryDurationSecs")
+	}
+	if tuning.Buffer == nil {
+		return true
+	}
+	buffer := tuning.Buffer
+	switch buffer.WhenFull {
+	case "", loggingv1.BufferWhenFullBlock, loggingv1.BufferWhenFullDropNewest:
+	default:
+		return fail("buffer whenFull must be one of %s or %s", loggingv1.BufferWhenFullBlock, loggingv1.BufferWhenFullDropNewest)
+	}
+	switch buffer.Type {
+	case loggingv1.BufferTypeDisk:
+		if buffer.MaxEvents != nil {
+			return fail("buffer maxEvents is only valid for memory buffers")
+		}
+		if buffer.MaxSize == nil {
+			return fail("disk buffer requires maxSize")
+		}
+		if buffer.MaxSize.Cmp(loggingv1.MinDiskBufferSize) < 0 {
+			return fail("disk buffer maxSize must be at least %s bytes", loggingv1.MinDiskBufferSize.String())
+		}
+	case "", loggingv1.BufferTypeMemory:
+		if buffer.MaxSize != nil {
+			return fail("buffer maxSize is only valid for disk buffers")
+		}
+		if buffer.MaxEvents != nil && *buffer.MaxEvents < 1 {
+			return fail("buffer maxEvents must be greater than zero")
+		}
+	default:
+		return fail("buffer type must be one of %s or %s", loggingv1.BufferTypeMemory, loggingv1.BufferTypeDisk)
+	}
+	return true
+}
+
+// validateOutputBufferResources verifies the disk buffers of all outputs on a node fit within the
+// ephemeral-storage limit of the collector, when one is set
+func validateOutputBufferResources(clf loggingv1.ClusterLogForwarder, k8sClient client.Client, extras map[string]bool) (error, *loggingv1.ClusterLogForwarderStatus) {
+	total := resource.Quantity{}
+	for _, output := range clf.Spec.Outputs {
+		if output.Tuning != nil && output.Tuning.Buffer != nil && output.Tuning.Buffer.Type == loggingv1.BufferTypeDisk && output.Tuning.Buffer.MaxSize != nil {
+			total.Add(*output.Tuning.Buffer.MaxSize)
+		}
+	}
+	if total.IsZero() || k8sClient == nil {
+		return nil, nil
+	}
+	cl := &loggingv1.ClusterLogging{}
+	if err := k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: clf.Namespace, Name: clf.Name}, cl); err != nil {
+		log.V(3).Info("validateOutputBufferResources: unable to get ClusterLogging, skipping", "error", err)
+		return nil, nil
+	}
+	if cl.Spec.Collection == nil || cl.Spec.Collection.Resources == nil {
+		return nil, nil
+	}
+	if limit, found := cl.Spec.Collection.Resources.Limits[corev1.ResourceEphemeralStorage]; found && total.Cmp(limit) > 0 {
+		return errors.NewValidationError("total disk buffer size %s of outputs exceeds the collector %s limit %s",
+			total.String(), corev1.ResourceEphemeralStorage, limit.String()), nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,130 @@
+package clusterlogforwarder
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	loggingv1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("[internal][validations] ClusterLogForwarder output tuning", func() {
+
+	vector := map[string]bool{constants.VectorName: true}
+
+	It("should fail tuning if the collector is not vector", func() {
+		conds := loggingv1.NamedConditions{}
+		output := &loggingv1.OutputSpec{Name: "my-output", Type: loggingv1.OutputTypeHttp, Tuning: &loggingv1.OutputTuningSpec{Compression: "gzip"}}
+		Expect(verifyOutputTuning(output, conds, map[string]bool{})).To(BeFalse())
+		Expect(conds["my-output"]).To(HaveCondition("Ready", false, loggingv1.ReasonInvalid, "tuning is only supported by the vector collector"))
+	})
+
+	DescribeTable("#verifyOutputTuning", func(buffer *loggingv1.OutputBufferSpec, message string) {
+		conds := loggingv1.NamedConditions{}
+		output := &loggingv1.OutputSpec{Name: "my-output", Tuning: &loggingv1.OutputTuningSpec{Buffer: buffer}}
+		if message == "" {
+			Expect(verifyOutputTuning(output, conds, vector)).To(BeTrue())
+		} else {
+			Expect(verifyOutputTuning(output, conds, vector)).To(BeFalse())
+			Expect(conds["my-output"]).To(HaveCondition("Ready", false, loggingv1.ReasonInvalid, message))
+		}
+	},
+		Entry("should pass without a buffer", nil, ""),
+		Entry("should pass a memory buffer", &loggingv1.OutputBufferSpec{MaxEvents: utils.GetPtr(int64(500)), WhenFull: loggingv1.BufferWhenFullBlock}, ""),
+		Entry("should pass a disk buffer", &loggingv1.OutputBufferSpec{Type: loggingv1.BufferTypeDisk, MaxSize: utils.GetPtr(resource.MustParse("1Gi"))}, ""),
+		Entry("should fail a disk buffer without maxSize", &loggingv1.OutputBufferSpec{Type: loggingv1.BufferTypeDisk}, "disk buffer requires maxSize"),
+		Entry("should fail a disk buffer smaller than the minimum", &loggingv1.OutputBufferSpec{Type: loggingv1.BufferTypeDisk, MaxSize: utils.GetPtr(resource.MustParse("256Mi"))}, "disk buffer maxSize must be at least 268435488 bytes"),
+		Entry("should fail a disk buffer with maxEvents", &loggingv1.OutputBufferSpec{Type: loggingv1.BufferTypeDisk, MaxSize: utils.GetPtr(resource.MustParse("1Gi")), MaxEvents: utils.GetPtr(int64(500))}, "buffer maxEvents is only valid for memory buffers"),
+		Entry("should fail a memory buffer with maxSize", &loggingv1.OutputBufferSpec{MaxSize: utils.GetPtr(resource.MustParse("1Gi"))}, "buffer maxSize is only valid for disk buffers"),
+		Entry("should fail an unknown whenFull", &loggingv1.OutputBufferSpec{WhenFull: "drop_oldest"}, "buffer whenFull must be one of block or drop_newest"),
+	)
+
+	DescribeTable("#verifyOutputTuning for delivery", func(outputType string, tuning loggingv1.OutputTuningSpec, message string) {
+		conds := loggingv1.NamedConditions{}
+		output := &loggingv1.OutputSpec{Name: "my-output", Type: outputType, Tuning: &tuning}
+		if message == "" {
+			Expect(verifyOutputTuning(output, conds, vector)).To(BeTrue())
+		} else {
+			Expect(verifyOutputTuning(output, conds, vector)).To(BeFalse())
+			Expect(conds["my-output"]).To(HaveCondition("Ready", false, loggingv1.ReasonInvalid, message))
+		}
+	},
+		Entry("should pass http with all tuning", loggingv1.OutputTypeHttp, loggingv1.OutputTuningSpec{
+			MaxBatchBytes: utils.GetPtr(int64(1000000)), MaxBatchEvents: utils.GetPtr(int64(100)), BatchTimeoutSecs: utils.GetPtr(int64(5)),
+			MinRetryDurationSecs: utils.GetPtr(int64(1)), MaxRetryDurationSecs: utils.GetPtr(int64(30)), Concurrency: "adaptive", Compression: "gzip",
+		}, ""),
+		Entry("should pass kafka with batch and lz4 compression", loggingv1.OutputTypeKafka, loggingv1.OutputTuningSpec{
+			MaxBatchEvents: utils.GetPtr(int64(100)), Compression: "lz4",
+		}, ""),
+		Entry("should fail kafka with concurrency", loggingv1.OutputTypeKafka, loggingv1.OutputTuningSpec{Concurrency: "2"},
+			"kafka output does not support retry or concurrency tuning"),
+		Entry("should fail syslog with batch tuning", loggingv1.OutputTypeSyslog, loggingv1.OutputTuningSpec{MaxBatchBytes: utils.GetPtr(int64(1000))},
+			"syslog output does not support batch tuning"),
+		Entry("should fail loki with zstd compression", loggingv1.OutputTypeLoki, loggingv1.OutputTuningSpec{Compression: "zstd"},
+			`loki output does not support compression "zstd"`),
+		Entry("should pass azureMonitor with no compression", loggingv1.OutputTypeAzureMonitor, loggingv1.OutputTuningSpec{Compression: "none"}, ""),
+		Entry("should fail azureMonitor with gzip compression", loggingv1.OutputTypeAzureMonitor, loggingv1.OutputTuningSpec{Compression: "gzip"},
+			`azureMonitor output does not support compression "gzip"`),
+		Entry("should fail googleCloudLogging with compression", loggingv1.OutputTypeGoogleCloudLogging, loggingv1.OutputTuningSpec{Compression: "gzip"},
+			`googleCloudLogging output does not support compression "gzip"`),
+		Entry("should pass otlp with maxBatchEvents", loggingv1.OutputTypeOTLP, loggingv1.OutputTuningSpec{MaxBatchEvents: utils.GetPtr(int64(500))}, ""),
+		Entry("should fail otlp with maxBatchBytes", loggingv1.OutputTypeOTLP, loggingv1.OutputTuningSpec{MaxBatchBytes: utils.GetPtr(int64(1000))},
+			"otlp output does not support maxBatchBytes tuning"),
+		Entry("should fail an invalid concurrency", loggingv1.OutputTypeHttp, loggingv1.OutputTuningSpec{Concurrency: "0"},
+			"concurrency must be adaptive, none or a positive number"),
+		Entry("should fail minRetryDurationSecs greater than maxRetryDurationSecs", loggingv1.OutputTypeHttp, loggingv1.OutputTuningSpec{
+			MinRetryDurationSecs: utils.GetPtr(int64(60)), MaxRetryDurationSecs: utils.GetPtr(int64(30)),
+		}, "minRetryDurationSecs must not be greater than maxRetryDurationSecs"),
+	)
+	Context("#validateOutputBufferResources", func() {
+		var clf *loggingv1.ClusterLogForwarder
+
+		BeforeEach(func() {
+			clf = runtime.NewClusterLogForwarder("my-ns", "my-clf")
+			clf.Spec.Outputs = []loggingv1.OutputSpec{
+				{
+					Name: "a",
+					Tuning: &loggingv1.OutputTuningSpec{Buffer: &loggingv1.OutputBufferSpec{
+						Type: loggingv1.BufferTypeDisk, MaxSize: utils.GetPtr(resource.MustParse("1Gi"))},
+					},
+				},
+				{
+					Name: "b",
+					Tuning: &loggingv1.OutputTuningSpec{Buffer: &loggingv1.OutputBufferSpec{
+						Type: loggingv1.BufferTypeDisk, MaxSize: utils.GetPtr(resource.MustParse("2Gi"))},
+					},
+				},
+			}
+		})
+
+		newClient := func(limit string) *fake.ClientBuilder {
+			cl := runtime.NewClusterLogging("my-ns", "my-clf")
+			cl.Spec.Collection = &loggingv1.CollectionSpec{
+				Type: loggingv1.LogCollectionTypeVector,
+				CollectorSpec: loggingv1.CollectorSpec{
+					Resources: &corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{corev1.ResourceEphemeralStorage: resource.MustParse(limit)},
+					},
+				},
+			}
+			return fake.NewClientBuilder().WithRuntimeObjects(cl)
+		}
+
+		It("should pass without a ClusterLogging", func() {
+			Expect(validateOutputBufferResources(*clf, fake.NewClientBuilder().Build(), nil)).To(Succeed())
+		})
+		It("should pass when disk buffers fit the collector limit", func() {
+			Expect(validateOutputBufferResources(*clf, newClient("3Gi").Build(), nil)).To(Succeed())
+		})
+		It("should fail when disk buffers exceed the collector limit", func() {
+			err, _ := validateOutputBufferResources(*clf, newClient("2Gi").Build(), nil)
+			Expect(err).To(MatchError("total disk buffer size 3Gi of outputs exceeds the collector ephemeral-storage limit 2Gi"))
+		})
+	})
+})
@@ -12,6 +12,12 @@ func Validate(clf v1.ClusterLogForwarder, k8sClient client.Client, extras map[st
 			return err, status
 		} else if status != nil {
 			returnStatus.Conditions = append(returnStatus.Conditions, status.Conditions...)
+			if status.Pipelines != nil {
+				returnStatus.Inputs = status.Inputs
+				returnStatus.Outputs = status.Outputs
+				returnStatus.Filters = status.Filters
+				returnStatus.Pipelines = status.Pipelines
+			}
 		}
 	}
 	return nil, &returnStatus
@@ -23,8 +29,6 @@ var validations = []func(clf v1.ClusterLogForwarder, k8sClient client.Client, ex
 	validateName,
 	ValidateClusterLoggingDependency,
 	ValidateInputsOutputsPipelines,
-	validateJsonParsingToElasticsearch,
-	validateUrlAccordingToTls,
-	validateHttpContentTypeHeaders,
+	validateOutputBufferResources,
 	ValidateServiceAccount,
 }
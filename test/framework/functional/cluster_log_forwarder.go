@@ -95,6 +95,15 @@ func (p *PipelineBuilder) ToCloudwatchOutput() *ClusterLogForwarderBuilder {
 	return p.ToOutputWithVisitor(func(output *logging.OutputSpec) {}, logging.OutputTypeCloudwatch)
 }
 
+func (p *PipelineBuilder) ToS3Output(visitors ...func(output *logging.OutputSpec)) *ClusterLogForwarderBuilder {
+	s3Visitor := func(output *logging.OutputSpec) {
+		for _, v := range visitors {
+			v(output)
+		}
+	}
+	return p.ToOutputWithVisitor(s3Visitor, logging.OutputTypeS3)
+}
+
 func (p *PipelineBuilder) ToSplunkOutput() *ClusterLogForwarderBuilder {
 	return p.ToOutputWithVisitor(func(output *logging.OutputSpec) {}, logging.OutputTypeSplunk)
 }
@@ -191,6 +200,24 @@ func (p *PipelineBuilder) ToOutputWithVisitor(visit OutputSpecVisitor, outputNam
 					InsecureSkipVerify: true,
 				},
 			}
+		case logging.OutputTypeS3:
+			output = &logging.OutputSpec{
+				Name: logging.OutputTypeS3,
+				Type: logging.OutputTypeS3,
+				URL:  "https://localhost:5000",
+				OutputTypeSpec: logging.OutputTypeSpec{
+					S3: &logging.S3{
+						Region: "us-east-1",
+						Bucket: "logs",
+					},
+				},
+				Secret: &logging.OutputSecretSpec{
+					Name: "s3",
+				},
+				TLS: &logging.OutputTLSSpec{
+					InsecureSkipVerify: true,
+				},
+			}
 		case logging.OutputTypeHttp:
 			output = &logging.OutputSpec{
 				Name: logging.OutputTypeHttp,
@@ -189,7 +189,7 @@ func (f *CollectorFunctionalFramework) DeployWithVisitors(visitors []runtime.Pod
 		return err
 	}
 	log.V(2).Info("Generating config", "forwarder", f.Forwarder)
-	f.Forwarder.Spec, _, _ = clusterlogforwarder.MigrateClusterLogForwarderSpec(f.Namespace, f.Name, f.Forwarder.Spec, nil, map[string]bool{}, "", "")
+	f.Forwarder.Spec, _, _ = clusterlogforwarder.MigrateClusterLogForwarderSpec(f.Namespace, f.Name, f.Forwarder.Spec, nil, map[string]bool{}, "", "", nil)
 	clfYaml, _ := yaml.Marshal(f.Forwarder)
 	debugOutput := false
 	testClient := client.Get().ControllerRuntimeClient()
@@ -402,6 +402,10 @@ func (f *CollectorFunctionalFramework) addOutputContainers(b *runtime.PodBuilder
 			if err := f.AddSplunkOutput(b, output); err != nil {
 				return err
 			}
+		case logging.OutputTypeS3:
+			if err := f.AddS3Output(b, output); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
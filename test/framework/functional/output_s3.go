@@ -0,0 +1,63 @@
+package functional
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+)
+
+const (
+	// S3MotoImage is an S3 compatible stand-in for Amazon S3
+	S3MotoImage = "quay.io/openshift-logging/moto:2.2.3.dev0"
+
+	s3MotoEndpoint = "https://localhost:5000"
+
+	// s3ReadObjects prints the newline delimited records of all the objects of a bucket with a key prefix
+	s3ReadObjects = `
+import boto3, gzip, sys
+s3 = boto3.client("s3", endpoint_url="%s", region_name="us-east-1", verify=False,
+                  aws_access_key_id="test", aws_secret_access_key="test")
+for o in s3.list_objects_v2(Bucket="%s", Prefix="%s").get("Contents", []):
+    body = s3.get_object(Bucket="%s", Key=o["Key"])["Body"].read()
+    if body[:2] == b"\x1f\x8b":
+        body = gzip.decompress(body)
+    sys.stdout.write(body.decode())
+`
+)
+
+// AddS3Output adds an S3 compatible stand-in container named after the output
+func (f *CollectorFunctionalFramework) AddS3Output(b *runtime.PodBuilder, output logging.OutputSpec) error {
+	log.V(2).Info("Adding s3 output", "name", output.Name)
+	b.AddContainer(strings.ToLower(output.Name), S3MotoImage).
+		WithCmdArgs([]string{"-s"}).
+		End()
+	return nil
+}
+
+// CreateS3Bucket creates a bucket in the S3 stand-in of an output
+func (f *CollectorFunctionalFramework) CreateS3Bucket(outputName, bucket string) error {
+	script := fmt.Sprintf(`
+import boto3
+boto3.client("s3", endpoint_url="%s", region_name="us-east-1", verify=False,
+             aws_access_key_id="test", aws_secret_access_key="test").create_bucket(Bucket="%s")
+`, s3MotoEndpoint, bucket)
+	_, err := f.RunCommand(outputName, "python3", "-c", script)
+	return err
+}
+
+// ReadLogsFromS3 reads the records of the objects of a bucket in the S3 stand-in of an output
+func (f *CollectorFunctionalFramework) ReadLogsFromS3(outputName, bucket, keyPrefix string) ([]string, error) {
+	script := fmt.Sprintf(s3ReadObjects, s3MotoEndpoint, bucket, keyPrefix, bucket)
+	out, err := f.RunCommand(outputName, "python3", "-c", script)
+	if err != nil {
+		return nil, err
+	}
+	out = strings.TrimSpace(out)
+	if out == "" {
+		return []string{}, nil
+	}
+	return strings.Split(out, "\n"), nil
+}
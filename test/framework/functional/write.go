@@ -190,6 +190,11 @@ func (f *CollectorFunctionalFramework) WriteToHttpInput(inputName, buf string) e
 }
 
 func (f *CollectorFunctionalFramework) WriteToHttpInputWithPortForwarder(inputName string, buf []byte) error {
+	return f.WriteToHttpInputWithPortForwarderAndHeader(inputName, buf, http.Header{})
+}
+
+// WriteToHttpInputWithPortForwarderAndHeader posts to a HTTP input with the additional request header, e.g. credentials
+func (f *CollectorFunctionalFramework) WriteToHttpInputWithPortForwarderAndHeader(inputName string, buf []byte, header http.Header) error {
 	for _, input := range f.Forwarder.Spec.Inputs {
 		if input.Receiver != nil && input.Receiver.HTTP != nil && input.Name == inputName {
 			pf, err := f.setupPortForwarder(input.Receiver.HTTP.Port)
@@ -198,7 +203,13 @@ func (f *CollectorFunctionalFramework) WriteToHttpInputWithPortForwarder(inputNa
 			}
 			defer close(pf.stopCh)
 			url := fmt.Sprintf("http://localhost:%d", pf.localPort)
-			resp, err := http.Post(url, "application/json", bytes.NewReader(buf))
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(buf))
+			if err != nil {
+				return err
+			}
+			req.Header = header.Clone()
+			req.Header.Set("Content-Type", "application/json")
+			resp, err := http.DefaultClient.Do(req)
 			if err == nil {
 				err = test.HTTPError(resp)
 			}
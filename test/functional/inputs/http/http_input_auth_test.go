@@ -0,0 +1,121 @@
+// go:build !fluentd
+package http
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	testfw "github.com/openshift/cluster-logging-operator/test/functional"
+)
+
+var _ = Describe("[Functional][Inputs][Http] Authentication", func() {
+
+	const (
+		authSecretName = "http-receiver-auth"
+		username       = "collector"
+		password       = "s3cr3t"
+		token          = "receiver-token"
+	)
+
+	var (
+		framework *functional.CollectorFunctionalFramework
+	)
+
+	deploy := func(authType string) {
+		framework = functional.NewCollectorFunctionalFrameworkUsingCollector(logging.LogCollectionTypeVector)
+		framework.VisitConfig = func(conf string) string {
+			return strings.Replace(conf, "enabled = true", "enabled = false", 2) // turn off TLS for testing
+		}
+		framework.AddSecret(runtime.NewSecret(framework.Namespace, authSecretName, map[string][]byte{
+			constants.ClientUsername:     []byte(username),
+			constants.ClientPassword:     []byte(password + "\n"), // trailing whitespace of the secret is ignored
+			constants.BearerTokenFileKey: []byte(token + "\n"),
+		}))
+		functional.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInputWithVisitor(httpInputName,
+				func(spec *logging.InputSpec) {
+					spec.Receiver = &logging.ReceiverSpec{
+						Type: logging.ReceiverTypeHttp,
+						ReceiverTypeSpec: &logging.ReceiverTypeSpec{
+							HTTP: &logging.HTTPReceiver{
+								Port:   servicePortNum,
+								Format: logging.FormatNDJSON,
+								Authentication: &logging.HTTPReceiverAuthentication{
+									Type:       authType,
+									SecretName: authSecretName,
+								},
+							},
+						},
+					}
+				}).ToHttpOutput()
+		Expect(framework.DeployWithVisitor(
+			func(b *runtime.PodBuilder) error {
+				return framework.AddVectorHttpOutput(b, framework.Forwarder.Spec.Outputs[0])
+			}),
+		).To(BeNil())
+	}
+
+	readMessages := func() []string {
+		raw, err := framework.ReadFileFromWithRetryInterval("http", functional.ApplicationLogFile, time.Second)
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		messages := []string{}
+		for _, line := range strings.Split(strings.TrimSpace(raw), "\n") {
+			record := map[string]interface{}{}
+			Expect(json.Unmarshal([]byte(line), &record)).To(Succeed())
+			Expect(record).ToNot(HaveKey("Authorization"))
+			messages = append(messages, record["message"].(string))
+		}
+		return messages
+	}
+
+	BeforeEach(func() {
+		if testfw.LogCollectionType != logging.LogCollectionTypeVector {
+			Skip("skip for non-vector")
+		}
+	})
+
+	AfterEach(func() {
+		if framework != nil {
+			framework.Cleanup()
+		}
+	})
+
+	It("should reject posts without valid basic auth credentials", func() {
+		deploy(logging.HTTPReceiverAuthBasic)
+
+		Expect(framework.WriteToHttpInputWithPortForwarder(httpInputName, []byte(`{"message":"anonymous"}`))).
+			To(MatchError(ContainSubstring("401")))
+		wrong := http.Header{}
+		wrong.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(username+":wrong")))
+		Expect(framework.WriteToHttpInputWithPortForwarderAndHeader(httpInputName, []byte(`{"message":"wrong"}`), wrong)).
+			To(MatchError(ContainSubstring("401")))
+
+		valid := http.Header{}
+		valid.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(username+":"+password)))
+		Expect(framework.WriteToHttpInputWithPortForwarderAndHeader(httpInputName, []byte(`{"message":"authenticated"}`), valid)).To(Succeed())
+		Expect(readMessages()).To(Equal([]string{"authenticated"}))
+	})
+	// The http_server source only supports basic auth, the posts are accepted and the records dropped
+	It("should drop the records of posts without a valid bearer token", func() {
+		deploy(logging.HTTPReceiverAuthBearerToken)
+
+		Expect(framework.WriteToHttpInputWithPortForwarder(httpInputName, []byte(`{"message":"anonymous"}`))).To(Succeed())
+		wrong := http.Header{}
+		wrong.Set("Authorization", "Bearer wrong")
+		Expect(framework.WriteToHttpInputWithPortForwarderAndHeader(httpInputName, []byte(`{"message":"wrong"}`), wrong)).To(Succeed())
+
+		valid := http.Header{}
+		valid.Set("Authorization", "Bearer "+token)
+		Expect(framework.WriteToHttpInputWithPortForwarderAndHeader(httpInputName, []byte(`{"message":"authenticated"}`), valid)).To(Succeed())
+		Expect(readMessages()).To(Equal([]string{"authenticated"}))
+	})
+})
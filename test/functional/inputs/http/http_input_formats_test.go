@@ -0,0 +1,111 @@
+// go:build !fluentd
+package http
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	logging "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	testfw "github.com/openshift/cluster-logging-operator/test/functional"
+)
+
+var _ = Describe("[Functional][Inputs][Http] Generic formats", func() {
+
+	var (
+		framework *functional.CollectorFunctionalFramework
+	)
+
+	deploy := func(receiver *logging.HTTPReceiver) {
+		framework = functional.NewCollectorFunctionalFrameworkUsingCollector(logging.LogCollectionTypeVector)
+		framework.VisitConfig = func(conf string) string {
+			return strings.Replace(conf, "enabled = true", "enabled = false", 2) // turn off TLS for testing
+		}
+		receiver.Port = servicePortNum
+		functional.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInputWithVisitor(httpInputName,
+				func(spec *logging.InputSpec) {
+					spec.Receiver = &logging.ReceiverSpec{
+						Type:             logging.ReceiverTypeHttp,
+						ReceiverTypeSpec: &logging.ReceiverTypeSpec{HTTP: receiver},
+					}
+				}).ToHttpOutput()
+		Expect(framework.DeployWithVisitor(
+			func(b *runtime.PodBuilder) error {
+				return framework.AddVectorHttpOutput(b, framework.Forwarder.Spec.Outputs[0])
+			}),
+		).To(BeNil())
+	}
+
+	readRecords := func(exp int) []map[string]interface{} {
+		raw, err := framework.ReadFileFromWithRetryInterval("http", functional.ApplicationLogFile, time.Second)
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		lines := strings.Split(strings.TrimSpace(raw), "\n")
+		Expect(lines).To(HaveLen(exp), "--- raw lines:\n%v\n...", raw)
+		records := []map[string]interface{}{}
+		for _, line := range lines {
+			record := map[string]interface{}{}
+			Expect(json.Unmarshal([]byte(line), &record)).To(Succeed())
+			records = append(records, record)
+		}
+		return records
+	}
+
+	BeforeEach(func() {
+		if testfw.LogCollectionType != logging.LogCollectionTypeVector {
+			Skip("skip for non-vector")
+		}
+	})
+
+	AfterEach(func() {
+		if framework != nil {
+			framework.Cleanup()
+		}
+	})
+
+	It("should map the fields of ndjson records and set the log type", func() {
+		deploy(&logging.HTTPReceiver{
+			Format:  logging.FormatNDJSON,
+			LogType: "serverless",
+			FieldMapping: &logging.HTTPReceiverFieldMapping{
+				Message:   ".msg",
+				Timestamp: ".ts",
+				Level:     ".severity",
+			},
+		})
+		body := `{"msg":"first","ts":"2024-01-01T00:00:00Z","severity":"warn"}` + "\n" +
+			`{"msg":"second","ts":"2024-01-01T00:00:01Z","severity":"error"}` + "\n"
+		Expect(framework.WriteToHttpInputWithPortForwarder(httpInputName, []byte(body))).To(Succeed())
+
+		records := readRecords(2)
+		for i, exp := range []struct{ message, timestamp, level string }{
+			{"first", "2024-01-01T00:00:00Z", "warn"},
+			{"second", "2024-01-01T00:00:01Z", "error"},
+		} {
+			Expect(records[i]["message"]).To(Equal(exp.message))
+			Expect(records[i]["@timestamp"]).To(Equal(exp.timestamp))
+			Expect(records[i]["level"]).To(Equal(exp.level))
+			Expect(records[i]["log_type"]).To(Equal("serverless"))
+			Expect(records[i]).ToNot(HaveKey("msg"))
+		}
+	})
+
+	It("should receive each line of plain text as an application record", func() {
+		deploy(&logging.HTTPReceiver{Format: logging.FormatText})
+		Expect(framework.WriteToHttpInputWithPortForwarder(httpInputName, []byte("level=error first\nsecond\n"))).To(Succeed())
+
+		records := readRecords(2)
+		Expect(records[0]["message"]).To(Equal("level=error first"))
+		Expect(records[0]["level"]).To(Equal("error"))
+		Expect(records[1]["message"]).To(Equal("second"))
+		Expect(records[1]["level"]).To(Equal("default"))
+		for _, record := range records {
+			Expect(record["log_type"]).To(Equal(logging.InputNameApplication))
+			Expect(record["@timestamp"]).ToNot(BeEmpty())
+		}
+	})
+})
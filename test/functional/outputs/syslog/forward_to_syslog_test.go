@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -85,6 +86,66 @@ var _ = Describe("[Functional][Outputs][Syslog] Functional tests", func() {
 			ReceivedLen := uint64(len(message))
 			Expect(ReceivedLen).To(BeEquivalentTo(MaxLen), "Expected the message length to be the same")
 		})
+		It("should truncate the JSON encoded record over UDP to maxMessageSize bytes", func() {
+			if testfw.LogCollectionType != logging.LogCollectionTypeVector {
+				Skip("Test requires vector")
+			}
+			functional.NewClusterLogForwarderBuilder(framework.Forwarder).
+				FromInput(logging.InputNameApplication).
+				ToOutputWithVisitor(join(setSyslogSpecValues, func(spec *logging.OutputSpec) {
+					spec.URL = "udp://0.0.0.0:24224"
+					spec.Syslog.MaxMessageSize = 1001
+				}), logging.OutputTypeSyslog)
+			Expect(framework.Deploy()).To(BeNil())
+
+			// Multi-byte characters, the limit splits one of them
+			log := functional.NewFullCRIOLogMessage(timestamp, strings.Repeat("é", 2000))
+			Expect(framework.WriteMessagesToApplicationLog(log, 1)).To(BeNil())
+			outputlogs, err := framework.ReadRawApplicationLogsFrom(logging.OutputTypeSyslog)
+			Expect(err).To(BeNil(), "Expected no errors reading the logs")
+			Expect(outputlogs).ToNot(BeEmpty())
+			fields := strings.SplitN(outputlogs[0], " - ", 2)
+			Expect(fields).To(HaveLen(2))
+			payload := strings.TrimSpace(fields[1])
+			Expect(payload).To(HavePrefix("{"), "Expected the JSON encoded record")
+			Expect(len(payload)).To(BeNumerically("<=", 1001), "Expected the payload to be limited in bytes")
+			Expect(len(payload)).To(BeNumerically(">", 990))
+			Expect(utf8.ValidString(payload)).To(BeTrue(), "Expected no split characters")
+		})
+		It("should send octet counted records with structured data over TCP", func() {
+			if testfw.LogCollectionType != logging.LogCollectionTypeVector {
+				Skip("Test requires vector")
+			}
+			functional.NewClusterLogForwarderBuilder(framework.Forwarder).
+				FromInput(logging.InputNameApplication).
+				ToOutputWithVisitor(join(setSyslogSpecValues, func(spec *logging.OutputSpec) {
+					spec.URL = "tcp://0.0.0.0:24224"
+					spec.Syslog.Framing = logging.SyslogFramingOctetCounting
+					spec.Syslog.StructuredData = &logging.SyslogStructuredData{
+						ID: "openshift@2312",
+						Params: map[string]string{
+							"namespace": "$.kubernetes.namespace_name",
+							"env":       `p"r]od`,
+						},
+					}
+				}), logging.OutputTypeSyslog)
+			Expect(framework.Deploy()).To(BeNil())
+
+			log := functional.NewFullCRIOLogMessage(timestamp, "octet counted")
+			Expect(framework.WriteMessagesToApplicationLog(log, 2)).To(BeNil())
+			outputlogs, err := framework.ReadRawApplicationLogsFrom(logging.OutputTypeSyslog)
+			Expect(err).To(BeNil(), "Expected no errors reading the logs")
+			Expect(outputlogs).ToNot(BeEmpty())
+			Expect(strings.Count(outputlogs[0], "octet counted")).To(Equal(1), "Expected the receiver to split the octet counted records")
+			fields := strings.SplitN(outputlogs[0], " ", 9)
+			Expect(fields).To(HaveLen(9))
+			Expect(fields[0]).To(Equal("<15>1"))
+			Expect(getAppName(fields)).To(Equal("myapp"))
+			Expect(getProcID(fields)).To(Equal("myproc"))
+			Expect(getMsgID(fields)).To(Equal("mymsg"))
+			sd := fmt.Sprintf(`[openshift@2312 env="p\"r\]od" namespace="%s"]`, framework.Namespace)
+			Expect(outputlogs[0]).To(ContainSubstring(" "+sd+" "), "Expected the structured data element")
+		})
 		It("should send NonJson App logs to syslog", func() {
 			functional.NewClusterLogForwarderBuilder(framework.Forwarder).
 				FromInput(logging.InputNameApplication).